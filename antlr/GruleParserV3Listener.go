@@ -92,6 +92,15 @@ type GruleV3ParserListener struct {
 	StopParse     bool
 	ErrorCallback *pkg.GruleErrorReporter
 	KnowledgeBase *ast.KnowledgeBase
+
+	// Resource is the description of the resource being parsed, it is recorded into each rule entry declaration.
+	Resource string
+	// CheckFunctionCalls makes the functions called without a fact an error, unless they are built-in functions
+	// or functions registered into the knowledge base's FunctionRegistry with the same number of parameters.
+	CheckFunctionCalls bool
+
+	resourceIndex int
+	position      int
 }
 
 // VisitTerminal is called when a terminal node is visited.
@@ -122,6 +131,8 @@ func (thisListener *GruleV3ParserListener) ExitEveryRule(ctx antlr.ParserRuleCon
 func (thisListener *GruleV3ParserListener) EnterGrl(ctx *grulev3.GrlContext) {
 	thisListener.Grl = ast.NewGrl()
 	thisListener.Stack.Push(thisListener.Grl)
+	thisListener.resourceIndex = thisListener.KnowledgeBase.NextResourceIndex()
+	thisListener.position = 0
 }
 
 // ExitGrl is called when production root is exited. The listener will instruct working memory re-index here.
@@ -135,12 +146,18 @@ func (thisListener *GruleV3ParserListener) ExitGrl(ctx *grulev3.GrlContext) {
 
 		return
 	}
-	for _, re := range thisListener.Grl.RuleEntries {
+	for _, re := range thisListener.Grl.OrderedRuleEntries() {
 		err := thisListener.KnowledgeBase.AddRuleEntry(re)
 		if err != nil {
 			thisListener.ErrorCallback.AddError(err)
 		}
 	}
+	for _, qe := range thisListener.Grl.OrderedQueryEntries() {
+		err := thisListener.KnowledgeBase.AddQueryEntry(qe)
+		if err != nil {
+			thisListener.ErrorCallback.AddError(err)
+		}
+	}
 }
 
 // EnterRuleEntry is called when production ruleEntry is entered.
@@ -151,6 +168,17 @@ func (thisListener *GruleV3ParserListener) EnterRuleEntry(ctx *grulev3.RuleEntry
 	}
 	entry := ast.NewRuleEntry()
 	entry.GrlText = ctx.GetText()
+	if ctx.RuleName() != nil {
+		entry.RuleName = ctx.RuleName().GetText()
+	}
+	entry.Declaration = ast.Declaration{
+		Resource:      thisListener.Resource,
+		ResourceIndex: thisListener.resourceIndex,
+		Position:      thisListener.position,
+		Line:          ctx.GetStart().GetLine(),
+		Column:        ctx.GetStart().GetColumn(),
+	}
+	thisListener.position++
 	thisListener.Stack.Push(entry)
 }
 
@@ -189,6 +217,77 @@ func (thisListener *GruleV3ParserListener) ExitRuleEntry(ctx *grulev3.RuleEntryC
 	}
 }
 
+// EnterQueryEntry is called when production queryEntry is entered.
+func (thisListener *GruleV3ParserListener) EnterQueryEntry(ctx *grulev3.QueryEntryContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	// query is not a GRL keyword, a query entry is any entry starting with it.
+	if keyword := ctx.SIMPLENAME(); !strings.EqualFold(keyword.GetText(), "query") {
+		thisListener.ErrorCallback.AddError(fmt.Errorf("line %d:%d expecting rule or query, got %s", keyword.GetSymbol().GetLine(), keyword.GetSymbol().GetColumn(), keyword.GetText()))
+		thisListener.StopParse = true
+
+		return
+	}
+	entry := ast.NewQueryEntry()
+	entry.GrlText = ctx.GetText()
+	entry.Declaration = ast.Declaration{
+		Resource:      thisListener.Resource,
+		ResourceIndex: thisListener.resourceIndex,
+		Position:      thisListener.position,
+		Line:          ctx.GetStart().GetLine(),
+		Column:        ctx.GetStart().GetColumn(),
+	}
+	thisListener.position++
+	thisListener.Stack.Push(entry)
+}
+
+// ExitQueryEntry is called when production queryEntry is exited.
+func (thisListener *GruleV3ParserListener) ExitQueryEntry(ctx *grulev3.QueryEntryContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	entry, popOk := thisListener.Stack.Pop().(*ast.QueryEntry)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+
+	if ctx.RuleName() != nil {
+		entry.QueryName = ctx.RuleName().GetText()
+	}
+	if ctx.RuleDescription() != nil {
+		txt := ctx.RuleDescription().GetText()
+		entry.QueryDescription = txt[1 : len(txt)-1]
+	}
+	if ctx.QueryParameters() != nil {
+		for _, parameter := range ctx.QueryParameters().AllSIMPLENAME() {
+			for _, name := range entry.Parameters {
+				if name == parameter.GetText() {
+					thisListener.ErrorCallback.AddError(fmt.Errorf("duplicate parameter %s in query %s", name, entry.QueryName))
+				}
+			}
+			entry.Parameters = append(entry.Parameters, parameter.GetText())
+		}
+	}
+
+	entryReceiver, popOk := thisListener.Stack.Peek().(ast.QueryEntryReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := entryReceiver.ReceiveQueryEntry(entry)
+	if err != nil {
+		thisListener.ErrorCallback.AddError(err)
+	} else {
+		LoggerV3.Debugf("Added QueryEntry : %s", entry.QueryName)
+	}
+}
+
 // EnterSalience is called when production salience is entered.
 func (thisListener *GruleV3ParserListener) EnterSalience(ctx *grulev3.SalienceContext) {
 	sal := ast.NewSalience(0)
@@ -220,6 +319,76 @@ func (thisListener *GruleV3ParserListener) ExitSalience(ctx *grulev3.SalienceCon
 	}
 }
 
+// EnterRuleAttribute is called when production ruleAttribute is entered.
+func (thisListener *GruleV3ParserListener) EnterRuleAttribute(ctx *grulev3.RuleAttributeContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thisListener.Stack.Push(ast.NewRuleAttribute())
+}
+
+// ExitRuleAttribute is called when production ruleAttribute is exited.
+func (thisListener *GruleV3ParserListener) ExitRuleAttribute(ctx *grulev3.RuleAttributeContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	attribute, popOk := thisListener.Stack.Pop().(*ast.RuleAttribute)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	attribute.Name = ctx.AttributeName().GetText()
+	attributeReceiver, popOk := thisListener.Stack.Peek().(ast.RuleAttributeReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := attributeReceiver.AcceptRuleAttribute(attribute)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
+// EnterAnnotation is called when production annotation is entered.
+func (thisListener *GruleV3ParserListener) EnterAnnotation(ctx *grulev3.AnnotationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	thisListener.Stack.Push(ast.NewAnnotation())
+}
+
+// ExitAnnotation is called when production annotation is exited.
+func (thisListener *GruleV3ParserListener) ExitAnnotation(ctx *grulev3.AnnotationContext) {
+	if thisListener.StopParse {
+
+		return
+	}
+	annotation, popOk := thisListener.Stack.Pop().(*ast.Annotation)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	annotation.Key = ctx.SIMPLENAME().GetText()
+	annotationReceiver, popOk := thisListener.Stack.Peek().(ast.AnnotationReceiver)
+	if !popOk {
+		thisListener.StopParse = true
+
+		return
+	}
+	err := annotationReceiver.AcceptAnnotation(annotation)
+	if err != nil {
+		thisListener.StopParse = true
+		thisListener.ErrorCallback.AddError(err)
+	}
+}
+
 // EnterWhenScope is called when production whenScope is entered.
 func (thisListener *GruleV3ParserListener) EnterWhenScope(ctx *grulev3.WhenScopeContext) {
 	if thisListener.StopParse {
@@ -600,6 +769,21 @@ func (thisListener *GruleV3ParserListener) ExitExpressionAtom(ctx *grulev3.Expre
 	}
 	expressionAtm.Negated = ctx.NEGATION() != nil
 
+	// a function called without a fact must be a built-in or a registered function.
+	if thisListener.CheckFunctionCalls && expressionAtm.FunctionCall != nil && expressionAtm.ExpressionAtom == nil {
+		argumentCount := 0
+		if expressionAtm.FunctionCall.ArgumentList != nil {
+			argumentCount = len(expressionAtm.FunctionCall.ArgumentList.Arguments)
+		}
+		err := thisListener.KnowledgeBase.Functions.CheckFunctionCall(expressionAtm.FunctionCall.FunctionName, argumentCount)
+		if err != nil {
+			thisListener.StopParse = true
+			thisListener.ErrorCallback.AddError(fmt.Errorf("grl error on %d:%d %w", ctx.GetStart().GetLine(), ctx.GetStart().GetColumn(), err))
+
+			return
+		}
+	}
+
 	err := expr.AcceptExpressionAtom(thisListener.KnowledgeBase.WorkingMemory.AddExpressionAtom(expressionAtm))
 	if err != nil {
 		thisListener.StopParse = true
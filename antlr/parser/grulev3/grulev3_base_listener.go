@@ -217,3 +217,39 @@ func (s *Basegrulev3Listener) EnterBooleanLiteral(ctx *BooleanLiteralContext) {}
 
 // ExitBooleanLiteral is called when production booleanLiteral is exited.
 func (s *Basegrulev3Listener) ExitBooleanLiteral(ctx *BooleanLiteralContext) {}
+
+// EnterRuleAttribute is called when production ruleAttribute is entered.
+func (s *Basegrulev3Listener) EnterRuleAttribute(ctx *RuleAttributeContext) {}
+
+// ExitRuleAttribute is called when production ruleAttribute is exited.
+func (s *Basegrulev3Listener) ExitRuleAttribute(ctx *RuleAttributeContext) {}
+
+// EnterAttributeName is called when production attributeName is entered.
+func (s *Basegrulev3Listener) EnterAttributeName(ctx *AttributeNameContext) {}
+
+// ExitAttributeName is called when production attributeName is exited.
+func (s *Basegrulev3Listener) ExitAttributeName(ctx *AttributeNameContext) {}
+
+// EnterAttributeValue is called when production attributeValue is entered.
+func (s *Basegrulev3Listener) EnterAttributeValue(ctx *AttributeValueContext) {}
+
+// ExitAttributeValue is called when production attributeValue is exited.
+func (s *Basegrulev3Listener) ExitAttributeValue(ctx *AttributeValueContext) {}
+
+// EnterAnnotation is called when production annotation is entered.
+func (s *Basegrulev3Listener) EnterAnnotation(ctx *AnnotationContext) {}
+
+// ExitAnnotation is called when production annotation is exited.
+func (s *Basegrulev3Listener) ExitAnnotation(ctx *AnnotationContext) {}
+
+// EnterQueryEntry is called when production queryEntry is entered.
+func (s *Basegrulev3Listener) EnterQueryEntry(ctx *QueryEntryContext) {}
+
+// ExitQueryEntry is called when production queryEntry is exited.
+func (s *Basegrulev3Listener) ExitQueryEntry(ctx *QueryEntryContext) {}
+
+// EnterQueryParameters is called when production queryParameters is entered.
+func (s *Basegrulev3Listener) EnterQueryParameters(ctx *QueryParametersContext) {}
+
+// ExitQueryParameters is called when production queryParameters is exited.
+func (s *Basegrulev3Listener) ExitQueryParameters(ctx *QueryParametersContext) {}
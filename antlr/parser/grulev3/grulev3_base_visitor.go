@@ -138,3 +138,27 @@ func (v *Basegrulev3Visitor) VisitStringLiteral(ctx *StringLiteralContext) inter
 func (v *Basegrulev3Visitor) VisitBooleanLiteral(ctx *BooleanLiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
+
+func (v *Basegrulev3Visitor) VisitRuleAttribute(ctx *RuleAttributeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitAttributeName(ctx *AttributeNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitAttributeValue(ctx *AttributeValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitAnnotation(ctx *AnnotationContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitQueryEntry(ctx *QueryEntryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *Basegrulev3Visitor) VisitQueryParameters(ctx *QueryParametersContext) interface{} {
+	return v.VisitChildren(ctx)
+}
@@ -106,6 +106,24 @@ type grulev3Listener interface {
 	// EnterBooleanLiteral is called when entering the booleanLiteral production.
 	EnterBooleanLiteral(c *BooleanLiteralContext)
 
+	// EnterRuleAttribute is called when entering the ruleAttribute production.
+	EnterRuleAttribute(c *RuleAttributeContext)
+
+	// EnterAttributeName is called when entering the attributeName production.
+	EnterAttributeName(c *AttributeNameContext)
+
+	// EnterAttributeValue is called when entering the attributeValue production.
+	EnterAttributeValue(c *AttributeValueContext)
+
+	// EnterAnnotation is called when entering the annotation production.
+	EnterAnnotation(c *AnnotationContext)
+
+	// EnterQueryEntry is called when entering the queryEntry production.
+	EnterQueryEntry(c *QueryEntryContext)
+
+	// EnterQueryParameters is called when entering the queryParameters production.
+	EnterQueryParameters(c *QueryParametersContext)
+
 	// ExitGrl is called when exiting the grl production.
 	ExitGrl(c *GrlContext)
 
@@ -204,4 +222,22 @@ type grulev3Listener interface {
 
 	// ExitBooleanLiteral is called when exiting the booleanLiteral production.
 	ExitBooleanLiteral(c *BooleanLiteralContext)
+
+	// ExitRuleAttribute is called when exiting the ruleAttribute production.
+	ExitRuleAttribute(c *RuleAttributeContext)
+
+	// ExitAttributeName is called when exiting the attributeName production.
+	ExitAttributeName(c *AttributeNameContext)
+
+	// ExitAttributeValue is called when exiting the attributeValue production.
+	ExitAttributeValue(c *AttributeValueContext)
+
+	// ExitAnnotation is called when exiting the annotation production.
+	ExitAnnotation(c *AnnotationContext)
+
+	// ExitQueryEntry is called when exiting the queryEntry production.
+	ExitQueryEntry(c *QueryEntryContext)
+
+	// ExitQueryParameters is called when exiting the queryParameters production.
+	ExitQueryParameters(c *QueryParametersContext)
 }
@@ -35,7 +35,8 @@ func grulev3ParserInit() {
 		"", "','", "'+'", "'-'", "'/'", "'*'", "'%'", "'.'", "';'", "'{'", "'}'",
 		"'('", "')'", "'['", "']'", "", "", "", "'&&'", "'||'", "", "", "",
 		"'!'", "", "'=='", "'='", "'+='", "'-='", "'/='", "'*='", "'>'", "'<'",
-		"'>='", "'<='", "'!='", "'&'", "'|'",
+		"'>='", "'<='", "'!='", "'&'", "'|'", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "'@'",
 	}
 	staticData.SymbolicNames = []string{
 		"", "", "PLUS", "MINUS", "DIV", "MUL", "MOD", "DOT", "SEMICOLON", "LR_BRACE",
@@ -45,7 +46,7 @@ func grulev3ParserInit() {
 		"DIV_ASIGN", "MUL_ASIGN", "GT", "LT", "GTE", "LTE", "NOTEQUALS", "BITAND",
 		"BITOR", "SIMPLENAME", "DQUOTA_STRING", "SQUOTA_STRING", "DECIMAL_FLOAT_LIT",
 		"DECIMAL_EXPONENT", "HEX_FLOAT_LIT", "HEX_EXPONENT", "DEC_LIT", "HEX_LIT",
-		"OCT_LIT", "SPACE", "COMMENT", "LINE_COMMENT",
+		"OCT_LIT", "SPACE", "COMMENT", "LINE_COMMENT", "AT",
 	}
 	staticData.RuleNames = []string{
 		"grl", "ruleEntry", "salience", "ruleName", "ruleDescription", "whenScope",
@@ -55,122 +56,151 @@ func grulev3ParserInit() {
 		"memberVariable", "functionCall", "methodCall", "argumentList", "floatLiteral",
 		"decimalFloatLiteral", "hexadecimalFloatLiteral", "integerLiteral",
 		"decimalLiteral", "hexadecimalLiteral", "octalLiteral", "stringLiteral",
-		"booleanLiteral",
+		"booleanLiteral", "ruleAttribute", "attributeName", "attributeValue",
+		"annotation", "queryEntry", "queryParameters",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 50, 263, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
-		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
-		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
-		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 1, 0, 5, 0, 68, 8, 0, 10, 0, 12, 0, 71, 9, 0, 1, 0, 1,
-		0, 1, 1, 1, 1, 1, 1, 3, 1, 78, 8, 1, 1, 1, 3, 1, 81, 8, 1, 1, 1, 1, 1,
-		1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5,
-		1, 5, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 4, 7, 104, 8, 7, 11, 7, 12, 7,
-		105, 1, 8, 1, 8, 3, 8, 110, 8, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10,
-		3, 10, 118, 8, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 125, 8, 10,
+		4, 1, 51, 334, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7, 4,
+		2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7, 10,
+		2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15, 2,
+		16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2, 21,
+		7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26, 7,
+		26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7, 31,
+		2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36, 2,
+		37, 7, 37, 2, 38, 7, 38, 1, 0, 1, 0, 3, 0, 81, 8, 0, 5, 0, 83, 8, 0, 10, 0,
+		12, 0, 86, 9, 0, 1, 0, 1, 0, 1, 1, 5, 1, 91, 8, 1, 10, 1, 12, 1, 94, 9, 1,
+		1, 1, 1, 1, 1, 1, 3, 1, 99, 8, 1, 1, 1, 3, 1, 102, 8, 1, 1, 1, 5, 1, 105,
+		8, 1, 10, 1, 12, 1, 108, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1,
+		2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7,
+		1, 7, 4, 7, 131, 8, 7, 11, 7, 12, 7, 132, 1, 8, 1, 8, 3, 8, 137, 8, 8, 1,
+		9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 3, 10, 145, 8, 10, 1, 10, 1, 10, 1, 10,
+		1, 10, 1, 10, 3, 10, 152, 8, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10,
 		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1,
-		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 5, 10,
-		147, 8, 10, 10, 10, 12, 10, 150, 9, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1,
-		13, 1, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16,
-		1, 16, 3, 16, 168, 8, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 5,
-		16, 176, 8, 16, 10, 16, 12, 16, 179, 9, 16, 1, 17, 1, 17, 1, 17, 1, 17,
-		1, 17, 3, 17, 186, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
-		18, 5, 18, 195, 8, 18, 10, 18, 12, 18, 198, 9, 18, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 3, 21, 210, 8, 21, 1,
-		21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 5, 23, 220, 8, 23,
-		10, 23, 12, 23, 223, 9, 23, 1, 24, 1, 24, 3, 24, 227, 8, 24, 1, 25, 3,
-		25, 230, 8, 25, 1, 25, 1, 25, 1, 26, 3, 26, 235, 8, 26, 1, 26, 1, 26, 1,
-		27, 1, 27, 1, 27, 3, 27, 242, 8, 27, 1, 28, 3, 28, 245, 8, 28, 1, 28, 1,
-		28, 1, 29, 3, 29, 250, 8, 29, 1, 29, 1, 29, 1, 30, 3, 30, 255, 8, 30, 1,
-		30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 0, 3, 20, 32, 36, 33, 0,
-		2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38,
-		40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 0, 6, 1, 0, 39, 40,
-		1, 0, 26, 30, 1, 0, 4, 6, 2, 0, 2, 3, 36, 37, 2, 0, 25, 25, 31, 35, 1,
-		0, 20, 21, 263, 0, 69, 1, 0, 0, 0, 2, 74, 1, 0, 0, 0, 4, 87, 1, 0, 0, 0,
-		6, 90, 1, 0, 0, 0, 8, 92, 1, 0, 0, 0, 10, 94, 1, 0, 0, 0, 12, 97, 1, 0,
-		0, 0, 14, 103, 1, 0, 0, 0, 16, 109, 1, 0, 0, 0, 18, 111, 1, 0, 0, 0, 20,
-		124, 1, 0, 0, 0, 22, 151, 1, 0, 0, 0, 24, 153, 1, 0, 0, 0, 26, 155, 1,
-		0, 0, 0, 28, 157, 1, 0, 0, 0, 30, 159, 1, 0, 0, 0, 32, 167, 1, 0, 0, 0,
-		34, 185, 1, 0, 0, 0, 36, 187, 1, 0, 0, 0, 38, 199, 1, 0, 0, 0, 40, 203,
-		1, 0, 0, 0, 42, 206, 1, 0, 0, 0, 44, 213, 1, 0, 0, 0, 46, 216, 1, 0, 0,
-		0, 48, 226, 1, 0, 0, 0, 50, 229, 1, 0, 0, 0, 52, 234, 1, 0, 0, 0, 54, 241,
-		1, 0, 0, 0, 56, 244, 1, 0, 0, 0, 58, 249, 1, 0, 0, 0, 60, 254, 1, 0, 0,
-		0, 62, 258, 1, 0, 0, 0, 64, 260, 1, 0, 0, 0, 66, 68, 3, 2, 1, 0, 67, 66,
-		1, 0, 0, 0, 68, 71, 1, 0, 0, 0, 69, 67, 1, 0, 0, 0, 69, 70, 1, 0, 0, 0,
-		70, 72, 1, 0, 0, 0, 71, 69, 1, 0, 0, 0, 72, 73, 5, 0, 0, 1, 73, 1, 1, 0,
-		0, 0, 74, 75, 5, 15, 0, 0, 75, 77, 3, 6, 3, 0, 76, 78, 3, 8, 4, 0, 77,
-		76, 1, 0, 0, 0, 77, 78, 1, 0, 0, 0, 78, 80, 1, 0, 0, 0, 79, 81, 3, 4, 2,
-		0, 80, 79, 1, 0, 0, 0, 80, 81, 1, 0, 0, 0, 81, 82, 1, 0, 0, 0, 82, 83,
-		5, 9, 0, 0, 83, 84, 3, 10, 5, 0, 84, 85, 3, 12, 6, 0, 85, 86, 5, 10, 0,
-		0, 86, 3, 1, 0, 0, 0, 87, 88, 5, 24, 0, 0, 88, 89, 3, 54, 27, 0, 89, 5,
-		1, 0, 0, 0, 90, 91, 5, 38, 0, 0, 91, 7, 1, 0, 0, 0, 92, 93, 7, 0, 0, 0,
-		93, 9, 1, 0, 0, 0, 94, 95, 5, 16, 0, 0, 95, 96, 3, 20, 10, 0, 96, 11, 1,
-		0, 0, 0, 97, 98, 5, 17, 0, 0, 98, 99, 3, 14, 7, 0, 99, 13, 1, 0, 0, 0,
-		100, 101, 3, 16, 8, 0, 101, 102, 5, 8, 0, 0, 102, 104, 1, 0, 0, 0, 103,
-		100, 1, 0, 0, 0, 104, 105, 1, 0, 0, 0, 105, 103, 1, 0, 0, 0, 105, 106,
-		1, 0, 0, 0, 106, 15, 1, 0, 0, 0, 107, 110, 3, 18, 9, 0, 108, 110, 3, 32,
-		16, 0, 109, 107, 1, 0, 0, 0, 109, 108, 1, 0, 0, 0, 110, 17, 1, 0, 0, 0,
-		111, 112, 3, 36, 18, 0, 112, 113, 7, 1, 0, 0, 113, 114, 3, 20, 10, 0, 114,
-		19, 1, 0, 0, 0, 115, 117, 6, 10, -1, 0, 116, 118, 5, 23, 0, 0, 117, 116,
-		1, 0, 0, 0, 117, 118, 1, 0, 0, 0, 118, 119, 1, 0, 0, 0, 119, 120, 5, 11,
-		0, 0, 120, 121, 3, 20, 10, 0, 121, 122, 5, 12, 0, 0, 122, 125, 1, 0, 0,
-		0, 123, 125, 3, 32, 16, 0, 124, 115, 1, 0, 0, 0, 124, 123, 1, 0, 0, 0,
-		125, 148, 1, 0, 0, 0, 126, 127, 10, 7, 0, 0, 127, 128, 3, 22, 11, 0, 128,
-		129, 3, 20, 10, 8, 129, 147, 1, 0, 0, 0, 130, 131, 10, 6, 0, 0, 131, 132,
-		3, 24, 12, 0, 132, 133, 3, 20, 10, 7, 133, 147, 1, 0, 0, 0, 134, 135, 10,
-		5, 0, 0, 135, 136, 3, 26, 13, 0, 136, 137, 3, 20, 10, 6, 137, 147, 1, 0,
-		0, 0, 138, 139, 10, 4, 0, 0, 139, 140, 3, 28, 14, 0, 140, 141, 3, 20, 10,
-		5, 141, 147, 1, 0, 0, 0, 142, 143, 10, 3, 0, 0, 143, 144, 3, 30, 15, 0,
-		144, 145, 3, 20, 10, 4, 145, 147, 1, 0, 0, 0, 146, 126, 1, 0, 0, 0, 146,
-		130, 1, 0, 0, 0, 146, 134, 1, 0, 0, 0, 146, 138, 1, 0, 0, 0, 146, 142,
-		1, 0, 0, 0, 147, 150, 1, 0, 0, 0, 148, 146, 1, 0, 0, 0, 148, 149, 1, 0,
-		0, 0, 149, 21, 1, 0, 0, 0, 150, 148, 1, 0, 0, 0, 151, 152, 7, 2, 0, 0,
-		152, 23, 1, 0, 0, 0, 153, 154, 7, 3, 0, 0, 154, 25, 1, 0, 0, 0, 155, 156,
-		7, 4, 0, 0, 156, 27, 1, 0, 0, 0, 157, 158, 5, 18, 0, 0, 158, 29, 1, 0,
-		0, 0, 159, 160, 5, 19, 0, 0, 160, 31, 1, 0, 0, 0, 161, 162, 6, 16, -1,
-		0, 162, 168, 3, 34, 17, 0, 163, 168, 3, 36, 18, 0, 164, 168, 3, 42, 21,
-		0, 165, 166, 5, 23, 0, 0, 166, 168, 3, 32, 16, 1, 167, 161, 1, 0, 0, 0,
-		167, 163, 1, 0, 0, 0, 167, 164, 1, 0, 0, 0, 167, 165, 1, 0, 0, 0, 168,
-		177, 1, 0, 0, 0, 169, 170, 10, 4, 0, 0, 170, 176, 3, 44, 22, 0, 171, 172,
-		10, 3, 0, 0, 172, 176, 3, 40, 20, 0, 173, 174, 10, 2, 0, 0, 174, 176, 3,
-		38, 19, 0, 175, 169, 1, 0, 0, 0, 175, 171, 1, 0, 0, 0, 175, 173, 1, 0,
-		0, 0, 176, 179, 1, 0, 0, 0, 177, 175, 1, 0, 0, 0, 177, 178, 1, 0, 0, 0,
-		178, 33, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0, 180, 186, 3, 62, 31, 0, 181,
-		186, 3, 54, 27, 0, 182, 186, 3, 48, 24, 0, 183, 186, 3, 64, 32, 0, 184,
-		186, 5, 22, 0, 0, 185, 180, 1, 0, 0, 0, 185, 181, 1, 0, 0, 0, 185, 182,
-		1, 0, 0, 0, 185, 183, 1, 0, 0, 0, 185, 184, 1, 0, 0, 0, 186, 35, 1, 0,
-		0, 0, 187, 188, 6, 18, -1, 0, 188, 189, 5, 38, 0, 0, 189, 196, 1, 0, 0,
-		0, 190, 191, 10, 3, 0, 0, 191, 195, 3, 40, 20, 0, 192, 193, 10, 2, 0, 0,
-		193, 195, 3, 38, 19, 0, 194, 190, 1, 0, 0, 0, 194, 192, 1, 0, 0, 0, 195,
-		198, 1, 0, 0, 0, 196, 194, 1, 0, 0, 0, 196, 197, 1, 0, 0, 0, 197, 37, 1,
-		0, 0, 0, 198, 196, 1, 0, 0, 0, 199, 200, 5, 13, 0, 0, 200, 201, 3, 20,
-		10, 0, 201, 202, 5, 14, 0, 0, 202, 39, 1, 0, 0, 0, 203, 204, 5, 7, 0, 0,
-		204, 205, 5, 38, 0, 0, 205, 41, 1, 0, 0, 0, 206, 207, 5, 38, 0, 0, 207,
-		209, 5, 11, 0, 0, 208, 210, 3, 46, 23, 0, 209, 208, 1, 0, 0, 0, 209, 210,
-		1, 0, 0, 0, 210, 211, 1, 0, 0, 0, 211, 212, 5, 12, 0, 0, 212, 43, 1, 0,
-		0, 0, 213, 214, 5, 7, 0, 0, 214, 215, 3, 42, 21, 0, 215, 45, 1, 0, 0, 0,
-		216, 221, 3, 20, 10, 0, 217, 218, 5, 1, 0, 0, 218, 220, 3, 20, 10, 0, 219,
-		217, 1, 0, 0, 0, 220, 223, 1, 0, 0, 0, 221, 219, 1, 0, 0, 0, 221, 222,
-		1, 0, 0, 0, 222, 47, 1, 0, 0, 0, 223, 221, 1, 0, 0, 0, 224, 227, 3, 50,
-		25, 0, 225, 227, 3, 52, 26, 0, 226, 224, 1, 0, 0, 0, 226, 225, 1, 0, 0,
-		0, 227, 49, 1, 0, 0, 0, 228, 230, 5, 3, 0, 0, 229, 228, 1, 0, 0, 0, 229,
-		230, 1, 0, 0, 0, 230, 231, 1, 0, 0, 0, 231, 232, 5, 41, 0, 0, 232, 51,
-		1, 0, 0, 0, 233, 235, 5, 3, 0, 0, 234, 233, 1, 0, 0, 0, 234, 235, 1, 0,
-		0, 0, 235, 236, 1, 0, 0, 0, 236, 237, 5, 43, 0, 0, 237, 53, 1, 0, 0, 0,
-		238, 242, 3, 56, 28, 0, 239, 242, 3, 58, 29, 0, 240, 242, 3, 60, 30, 0,
-		241, 238, 1, 0, 0, 0, 241, 239, 1, 0, 0, 0, 241, 240, 1, 0, 0, 0, 242,
-		55, 1, 0, 0, 0, 243, 245, 5, 3, 0, 0, 244, 243, 1, 0, 0, 0, 244, 245, 1,
-		0, 0, 0, 245, 246, 1, 0, 0, 0, 246, 247, 5, 45, 0, 0, 247, 57, 1, 0, 0,
-		0, 248, 250, 5, 3, 0, 0, 249, 248, 1, 0, 0, 0, 249, 250, 1, 0, 0, 0, 250,
-		251, 1, 0, 0, 0, 251, 252, 5, 46, 0, 0, 252, 59, 1, 0, 0, 0, 253, 255,
-		5, 3, 0, 0, 254, 253, 1, 0, 0, 0, 254, 255, 1, 0, 0, 0, 255, 256, 1, 0,
-		0, 0, 256, 257, 5, 47, 0, 0, 257, 61, 1, 0, 0, 0, 258, 259, 7, 0, 0, 0,
-		259, 63, 1, 0, 0, 0, 260, 261, 7, 5, 0, 0, 261, 65, 1, 0, 0, 0, 24, 69,
-		77, 80, 105, 109, 117, 124, 146, 148, 167, 175, 177, 185, 194, 196, 209,
-		221, 226, 229, 234, 241, 244, 249, 254,
+		10, 1, 10, 1, 10, 1, 10, 5, 10, 174, 8, 10, 10, 10, 12, 10, 177, 9, 10, 1,
+		11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16,
+		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 195, 8, 16, 1, 16, 1, 16, 1, 16,
+		1, 16, 1, 16, 1, 16, 5, 16, 203, 8, 16, 10, 16, 12, 16, 206, 9, 16, 1, 17,
+		1, 17, 1, 17, 1, 17, 1, 17, 3, 17, 213, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18,
+		1, 18, 1, 18, 1, 18, 5, 18, 222, 8, 18, 10, 18, 12, 18, 225, 9, 18, 1, 19,
+		1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 3, 21, 237,
+		8, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 5, 23, 247,
+		8, 23, 10, 23, 12, 23, 250, 9, 23, 1, 24, 1, 24, 3, 24, 254, 8, 24, 1, 25,
+		3, 25, 257, 8, 25, 1, 25, 1, 25, 1, 26, 3, 26, 262, 8, 26, 1, 26, 1, 26, 1,
+		27, 1, 27, 1, 27, 3, 27, 269, 8, 27, 1, 28, 3, 28, 272, 8, 28, 1, 28, 1,
+		28, 1, 29, 3, 29, 277, 8, 29, 1, 29, 1, 29, 1, 30, 3, 30, 282, 8, 30, 1,
+		30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 3, 33, 293, 8,
+		33, 1, 34, 1, 34, 1, 34, 5, 34, 298, 8, 34, 10, 34, 12, 34, 301, 9, 34, 1,
+		35, 1, 35, 3, 35, 305, 8, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1,
+		37, 1, 37, 1, 37, 1, 37, 3, 37, 317, 8, 37, 1, 37, 1, 37, 3, 37, 321, 8,
+		37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 5, 38, 330, 8, 38, 10,
+		38, 12, 38, 333, 9, 38, 0, 3, 20, 32, 36, 39, 0, 2, 4, 6, 8, 10, 12, 14,
+		16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52,
+		54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 0, 6, 1, 0, 39, 40, 1, 0,
+		26, 30, 1, 0, 4, 6, 2, 0, 2, 3, 36, 37, 2, 0, 25, 25, 31, 35, 1, 0, 20, 21,
+		337, 0, 84, 1, 0, 0, 0, 2, 92, 1, 0, 0, 0, 4, 114, 1, 0, 0, 0, 6, 117, 1,
+		0, 0, 0, 8, 119, 1, 0, 0, 0, 10, 121, 1, 0, 0, 0, 12, 124, 1, 0, 0, 0, 14,
+		130, 1, 0, 0, 0, 16, 136, 1, 0, 0, 0, 18, 138, 1, 0, 0, 0, 20, 151, 1, 0,
+		0, 0, 22, 178, 1, 0, 0, 0, 24, 180, 1, 0, 0, 0, 26, 182, 1, 0, 0, 0, 28,
+		184, 1, 0, 0, 0, 30, 186, 1, 0, 0, 0, 32, 194, 1, 0, 0, 0, 34, 212, 1, 0,
+		0, 0, 36, 214, 1, 0, 0, 0, 38, 226, 1, 0, 0, 0, 40, 230, 1, 0, 0, 0, 42,
+		233, 1, 0, 0, 0, 44, 240, 1, 0, 0, 0, 46, 243, 1, 0, 0, 0, 48, 253, 1, 0,
+		0, 0, 50, 256, 1, 0, 0, 0, 52, 261, 1, 0, 0, 0, 54, 268, 1, 0, 0, 0, 56,
+		271, 1, 0, 0, 0, 58, 276, 1, 0, 0, 0, 60, 281, 1, 0, 0, 0, 62, 285, 1, 0,
+		0, 0, 64, 287, 1, 0, 0, 0, 66, 290, 1, 0, 0, 0, 68, 294, 1, 0, 0, 0, 70,
+		304, 1, 0, 0, 0, 72, 306, 1, 0, 0, 0, 74, 312, 1, 0, 0, 0, 76, 326, 1, 0,
+		0, 0, 78, 81, 3, 2, 1, 0, 79, 81, 3, 74, 37, 0, 80, 78, 1, 0, 0, 0, 80, 79,
+		1, 0, 0, 0, 81, 83, 1, 0, 0, 0, 82, 80, 1, 0, 0, 0, 83, 86, 1, 0, 0, 0, 84,
+		82, 1, 0, 0, 0, 84, 85, 1, 0, 0, 0, 85, 87, 1, 0, 0, 0, 86, 84, 1, 0, 0, 0,
+		87, 88, 5, 0, 0, 1, 88, 1, 1, 0, 0, 0, 89, 91, 3, 72, 36, 0, 90, 89, 1, 0,
+		0, 0, 91, 94, 1, 0, 0, 0, 92, 90, 1, 0, 0, 0, 92, 93, 1, 0, 0, 0, 93, 95,
+		1, 0, 0, 0, 94, 92, 1, 0, 0, 0, 95, 96, 5, 15, 0, 0, 96, 98, 3, 6, 3, 0,
+		97, 99, 3, 8, 4, 0, 98, 97, 1, 0, 0, 0, 98, 99, 1, 0, 0, 0, 99, 101, 1, 0,
+		0, 0, 100, 102, 3, 4, 2, 0, 101, 100, 1, 0, 0, 0, 101, 102, 1, 0, 0, 0,
+		102, 106, 1, 0, 0, 0, 103, 105, 3, 66, 33, 0, 104, 103, 1, 0, 0, 0, 105,
+		108, 1, 0, 0, 0, 106, 104, 1, 0, 0, 0, 106, 107, 1, 0, 0, 0, 107, 109, 1,
+		0, 0, 0, 108, 106, 1, 0, 0, 0, 109, 110, 5, 9, 0, 0, 110, 111, 3, 10, 5, 0,
+		111, 112, 3, 12, 6, 0, 112, 113, 5, 10, 0, 0, 113, 3, 1, 0, 0, 0, 114, 115,
+		5, 24, 0, 0, 115, 116, 3, 54, 27, 0, 116, 5, 1, 0, 0, 0, 117, 118, 5, 38,
+		0, 0, 118, 7, 1, 0, 0, 0, 119, 120, 7, 0, 0, 0, 120, 9, 1, 0, 0, 0, 121,
+		122, 5, 16, 0, 0, 122, 123, 3, 20, 10, 0, 123, 11, 1, 0, 0, 0, 124, 125, 5,
+		17, 0, 0, 125, 126, 3, 14, 7, 0, 126, 13, 1, 0, 0, 0, 127, 128, 3, 16, 8,
+		0, 128, 129, 5, 8, 0, 0, 129, 131, 1, 0, 0, 0, 130, 127, 1, 0, 0, 0, 131,
+		132, 1, 0, 0, 0, 132, 130, 1, 0, 0, 0, 132, 133, 1, 0, 0, 0, 133, 15, 1, 0,
+		0, 0, 134, 137, 3, 18, 9, 0, 135, 137, 3, 32, 16, 0, 136, 134, 1, 0, 0, 0,
+		136, 135, 1, 0, 0, 0, 137, 17, 1, 0, 0, 0, 138, 139, 3, 36, 18, 0, 139,
+		140, 7, 1, 0, 0, 140, 141, 3, 20, 10, 0, 141, 19, 1, 0, 0, 0, 142, 144, 6,
+		10, -1, 0, 143, 145, 5, 23, 0, 0, 144, 143, 1, 0, 0, 0, 144, 145, 1, 0, 0,
+		0, 145, 146, 1, 0, 0, 0, 146, 147, 5, 11, 0, 0, 147, 148, 3, 20, 10, 0,
+		148, 149, 5, 12, 0, 0, 149, 152, 1, 0, 0, 0, 150, 152, 3, 32, 16, 0, 151,
+		142, 1, 0, 0, 0, 151, 150, 1, 0, 0, 0, 152, 175, 1, 0, 0, 0, 153, 154, 10,
+		7, 0, 0, 154, 155, 3, 22, 11, 0, 155, 156, 3, 20, 10, 8, 156, 174, 1, 0, 0,
+		0, 157, 158, 10, 6, 0, 0, 158, 159, 3, 24, 12, 0, 159, 160, 3, 20, 10, 7,
+		160, 174, 1, 0, 0, 0, 161, 162, 10, 5, 0, 0, 162, 163, 3, 26, 13, 0, 163,
+		164, 3, 20, 10, 6, 164, 174, 1, 0, 0, 0, 165, 166, 10, 4, 0, 0, 166, 167,
+		3, 28, 14, 0, 167, 168, 3, 20, 10, 5, 168, 174, 1, 0, 0, 0, 169, 170, 10,
+		3, 0, 0, 170, 171, 3, 30, 15, 0, 171, 172, 3, 20, 10, 4, 172, 174, 1, 0, 0,
+		0, 173, 153, 1, 0, 0, 0, 173, 157, 1, 0, 0, 0, 173, 161, 1, 0, 0, 0, 173,
+		165, 1, 0, 0, 0, 173, 169, 1, 0, 0, 0, 174, 177, 1, 0, 0, 0, 175, 173, 1,
+		0, 0, 0, 175, 176, 1, 0, 0, 0, 176, 21, 1, 0, 0, 0, 177, 175, 1, 0, 0, 0,
+		178, 179, 7, 2, 0, 0, 179, 23, 1, 0, 0, 0, 180, 181, 7, 3, 0, 0, 181, 25,
+		1, 0, 0, 0, 182, 183, 7, 4, 0, 0, 183, 27, 1, 0, 0, 0, 184, 185, 5, 18, 0,
+		0, 185, 29, 1, 0, 0, 0, 186, 187, 5, 19, 0, 0, 187, 31, 1, 0, 0, 0, 188,
+		189, 6, 16, -1, 0, 189, 195, 3, 34, 17, 0, 190, 195, 3, 36, 18, 0, 191,
+		195, 3, 42, 21, 0, 192, 193, 5, 23, 0, 0, 193, 195, 3, 32, 16, 1, 194, 188,
+		1, 0, 0, 0, 194, 190, 1, 0, 0, 0, 194, 191, 1, 0, 0, 0, 194, 192, 1, 0, 0,
+		0, 195, 204, 1, 0, 0, 0, 196, 197, 10, 4, 0, 0, 197, 203, 3, 44, 22, 0,
+		198, 199, 10, 3, 0, 0, 199, 203, 3, 40, 20, 0, 200, 201, 10, 2, 0, 0, 201,
+		203, 3, 38, 19, 0, 202, 196, 1, 0, 0, 0, 202, 198, 1, 0, 0, 0, 202, 200, 1,
+		0, 0, 0, 203, 206, 1, 0, 0, 0, 204, 202, 1, 0, 0, 0, 204, 205, 1, 0, 0, 0,
+		205, 33, 1, 0, 0, 0, 206, 204, 1, 0, 0, 0, 207, 213, 3, 62, 31, 0, 208,
+		213, 3, 54, 27, 0, 209, 213, 3, 48, 24, 0, 210, 213, 3, 64, 32, 0, 211,
+		213, 5, 22, 0, 0, 212, 207, 1, 0, 0, 0, 212, 208, 1, 0, 0, 0, 212, 209, 1,
+		0, 0, 0, 212, 210, 1, 0, 0, 0, 212, 211, 1, 0, 0, 0, 213, 35, 1, 0, 0, 0,
+		214, 215, 6, 18, -1, 0, 215, 216, 5, 38, 0, 0, 216, 223, 1, 0, 0, 0, 217,
+		218, 10, 3, 0, 0, 218, 222, 3, 40, 20, 0, 219, 220, 10, 2, 0, 0, 220, 222,
+		3, 38, 19, 0, 221, 217, 1, 0, 0, 0, 221, 219, 1, 0, 0, 0, 222, 225, 1, 0,
+		0, 0, 223, 221, 1, 0, 0, 0, 223, 224, 1, 0, 0, 0, 224, 37, 1, 0, 0, 0, 225,
+		223, 1, 0, 0, 0, 226, 227, 5, 13, 0, 0, 227, 228, 3, 20, 10, 0, 228, 229,
+		5, 14, 0, 0, 229, 39, 1, 0, 0, 0, 230, 231, 5, 7, 0, 0, 231, 232, 5, 38, 0,
+		0, 232, 41, 1, 0, 0, 0, 233, 234, 5, 38, 0, 0, 234, 236, 5, 11, 0, 0, 235,
+		237, 3, 46, 23, 0, 236, 235, 1, 0, 0, 0, 236, 237, 1, 0, 0, 0, 237, 238, 1,
+		0, 0, 0, 238, 239, 5, 12, 0, 0, 239, 43, 1, 0, 0, 0, 240, 241, 5, 7, 0, 0,
+		241, 242, 3, 42, 21, 0, 242, 45, 1, 0, 0, 0, 243, 248, 3, 20, 10, 0, 244,
+		245, 5, 1, 0, 0, 245, 247, 3, 20, 10, 0, 246, 244, 1, 0, 0, 0, 247, 250, 1,
+		0, 0, 0, 248, 246, 1, 0, 0, 0, 248, 249, 1, 0, 0, 0, 249, 47, 1, 0, 0, 0,
+		250, 248, 1, 0, 0, 0, 251, 254, 3, 50, 25, 0, 252, 254, 3, 52, 26, 0, 253,
+		251, 1, 0, 0, 0, 253, 252, 1, 0, 0, 0, 254, 49, 1, 0, 0, 0, 255, 257, 5, 3,
+		0, 0, 256, 255, 1, 0, 0, 0, 256, 257, 1, 0, 0, 0, 257, 258, 1, 0, 0, 0,
+		258, 259, 5, 41, 0, 0, 259, 51, 1, 0, 0, 0, 260, 262, 5, 3, 0, 0, 261, 260,
+		1, 0, 0, 0, 261, 262, 1, 0, 0, 0, 262, 263, 1, 0, 0, 0, 263, 264, 5, 43, 0,
+		0, 264, 53, 1, 0, 0, 0, 265, 269, 3, 56, 28, 0, 266, 269, 3, 58, 29, 0,
+		267, 269, 3, 60, 30, 0, 268, 265, 1, 0, 0, 0, 268, 266, 1, 0, 0, 0, 268,
+		267, 1, 0, 0, 0, 269, 55, 1, 0, 0, 0, 270, 272, 5, 3, 0, 0, 271, 270, 1, 0,
+		0, 0, 271, 272, 1, 0, 0, 0, 272, 273, 1, 0, 0, 0, 273, 274, 5, 45, 0, 0,
+		274, 57, 1, 0, 0, 0, 275, 277, 5, 3, 0, 0, 276, 275, 1, 0, 0, 0, 276, 277,
+		1, 0, 0, 0, 277, 278, 1, 0, 0, 0, 278, 279, 5, 46, 0, 0, 279, 59, 1, 0, 0,
+		0, 280, 282, 5, 3, 0, 0, 281, 280, 1, 0, 0, 0, 281, 282, 1, 0, 0, 0, 282,
+		283, 1, 0, 0, 0, 283, 284, 5, 47, 0, 0, 284, 61, 1, 0, 0, 0, 285, 286, 7,
+		0, 0, 0, 286, 63, 1, 0, 0, 0, 287, 288, 7, 5, 0, 0, 288, 65, 1, 0, 0, 0,
+		290, 292, 3, 68, 34, 0, 291, 293, 3, 70, 35, 0, 292, 291, 1, 0, 0, 0, 292,
+		293, 1, 0, 0, 0, 293, 67, 1, 0, 0, 0, 294, 299, 5, 38, 0, 0, 295, 296, 5,
+		3, 0, 0, 296, 298, 5, 38, 0, 0, 297, 295, 1, 0, 0, 0, 298, 301, 1, 0, 0, 0,
+		299, 297, 1, 0, 0, 0, 299, 300, 1, 0, 0, 0, 300, 69, 1, 0, 0, 0, 301, 299,
+		1, 0, 0, 0, 302, 305, 3, 62, 31, 0, 303, 305, 3, 64, 32, 0, 304, 302, 1, 0,
+		0, 0, 304, 303, 1, 0, 0, 0, 305, 71, 1, 0, 0, 0, 306, 307, 5, 51, 0, 0,
+		307, 308, 5, 38, 0, 0, 308, 309, 5, 11, 0, 0, 309, 310, 3, 62, 31, 0, 310,
+		311, 5, 12, 0, 0, 311, 73, 1, 0, 0, 0, 312, 313, 5, 38, 0, 0, 313, 314, 3,
+		6, 3, 0, 314, 316, 5, 11, 0, 0, 315, 317, 3, 76, 38, 0, 316, 315, 1, 0, 0,
+		0, 316, 317, 1, 0, 0, 0, 317, 318, 1, 0, 0, 0, 318, 320, 5, 12, 0, 0, 319,
+		321, 3, 8, 4, 0, 320, 319, 1, 0, 0, 0, 320, 321, 1, 0, 0, 0, 321, 322, 1,
+		0, 0, 0, 322, 323, 5, 9, 0, 0, 323, 324, 3, 20, 10, 0, 324, 325, 5, 10, 0,
+		0, 325, 75, 1, 0, 0, 0, 326, 331, 5, 38, 0, 0, 327, 328, 5, 1, 0, 0, 328,
+		330, 5, 38, 0, 0, 329, 327, 1, 0, 0, 0, 330, 333, 1, 0, 0, 0, 331, 329, 1,
+		0, 0, 0, 331, 332, 1, 0, 0, 0, 332, 77, 1, 0, 0, 0, 333, 331, 1, 0, 0, 0,
+		33, 80, 84, 92, 98, 101, 106, 132, 136, 144, 151, 173, 175, 194, 202, 204,
+		212, 221, 223, 236, 248, 253, 256, 261, 268, 271, 276, 281, 292, 299, 304,
+		316, 320, 331,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -259,6 +289,7 @@ const (
 	grulev3ParserSPACE             = 48
 	grulev3ParserCOMMENT           = 49
 	grulev3ParserLINE_COMMENT      = 50
+	grulev3ParserAT                = 51
 )
 
 // grulev3Parser rules.
@@ -296,6 +327,12 @@ const (
 	grulev3ParserRULE_octalLiteral            = 30
 	grulev3ParserRULE_stringLiteral           = 31
 	grulev3ParserRULE_booleanLiteral          = 32
+	grulev3ParserRULE_ruleAttribute           = 33
+	grulev3ParserRULE_attributeName           = 34
+	grulev3ParserRULE_attributeValue          = 35
+	grulev3ParserRULE_annotation              = 36
+	grulev3ParserRULE_queryEntry              = 37
+	grulev3ParserRULE_queryParameters         = 38
 )
 
 // IGrlContext is an interface to support dynamic dispatch.
@@ -309,6 +346,8 @@ type IGrlContext interface {
 	EOF() antlr.TerminalNode
 	AllRuleEntry() []IRuleEntryContext
 	RuleEntry(i int) IRuleEntryContext
+	AllQueryEntry() []IQueryEntryContext
+	QueryEntry(i int) IQueryEntryContext
 
 	// IsGrlContext differentiates from other interfaces.
 	IsGrlContext()
@@ -391,6 +430,47 @@ func (s *GrlContext) RuleEntry(i int) IRuleEntryContext {
 	return t.(IRuleEntryContext)
 }
 
+func (s *GrlContext) AllQueryEntry() []IQueryEntryContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IQueryEntryContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IQueryEntryContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IQueryEntryContext); ok {
+			tst[i] = t.(IQueryEntryContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GrlContext) QueryEntry(i int) IQueryEntryContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryEntryContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryEntryContext)
+}
+
 func (s *GrlContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -427,20 +507,39 @@ func (p *grulev3Parser) Grl() (localctx IGrlContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(69)
+	p.SetState(84)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == grulev3ParserRULE {
-		{
-			p.SetState(66)
-			p.RuleEntry()
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2252074691624960) != 0 {
+		p.SetState(80)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case grulev3ParserRULE, grulev3ParserAT:
+			{
+				p.SetState(78)
+				p.RuleEntry()
+			}
+
+		case grulev3ParserSIMPLENAME:
+			{
+				p.SetState(79)
+				p.QueryEntry()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
 		}
 
-		p.SetState(71)
+		p.SetState(86)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -448,7 +547,7 @@ func (p *grulev3Parser) Grl() (localctx IGrlContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(72)
+		p.SetState(87)
 		p.Match(grulev3ParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -485,6 +584,10 @@ type IRuleEntryContext interface {
 	RR_BRACE() antlr.TerminalNode
 	RuleDescription() IRuleDescriptionContext
 	Salience() ISalienceContext
+	AllRuleAttribute() []IRuleAttributeContext
+	RuleAttribute(i int) IRuleAttributeContext
+	AllAnnotation() []IAnnotationContext
+	Annotation(i int) IAnnotationContext
 
 	// IsRuleEntryContext differentiates from other interfaces.
 	IsRuleEntryContext()
@@ -614,6 +717,88 @@ func (s *RuleEntryContext) Salience() ISalienceContext {
 	return t.(ISalienceContext)
 }
 
+func (s *RuleEntryContext) AllRuleAttribute() []IRuleAttributeContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IRuleAttributeContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IRuleAttributeContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IRuleAttributeContext); ok {
+			tst[i] = t.(IRuleAttributeContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RuleEntryContext) RuleAttribute(i int) IRuleAttributeContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRuleAttributeContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRuleAttributeContext)
+}
+
+func (s *RuleEntryContext) AllAnnotation() []IAnnotationContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IAnnotationContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IAnnotationContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IAnnotationContext); ok {
+			tst[i] = t.(IAnnotationContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RuleEntryContext) Annotation(i int) IAnnotationContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAnnotationContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAnnotationContext)
+}
+
 func (s *RuleEntryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -650,8 +835,28 @@ func (p *grulev3Parser) RuleEntry() (localctx IRuleEntryContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
+	p.SetState(92)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == grulev3ParserAT {
+		{
+			p.SetState(89)
+			p.Annotation()
+		}
+
+		p.SetState(94)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
 	{
-		p.SetState(74)
+		p.SetState(95)
 		p.Match(grulev3ParserRULE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -659,10 +864,10 @@ func (p *grulev3Parser) RuleEntry() (localctx IRuleEntryContext) {
 		}
 	}
 	{
-		p.SetState(75)
+		p.SetState(96)
 		p.RuleName()
 	}
-	p.SetState(77)
+	p.SetState(98)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -671,12 +876,12 @@ func (p *grulev3Parser) RuleEntry() (localctx IRuleEntryContext) {
 
 	if _la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING {
 		{
-			p.SetState(76)
+			p.SetState(97)
 			p.RuleDescription()
 		}
 
 	}
-	p.SetState(80)
+	p.SetState(101)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -685,13 +890,33 @@ func (p *grulev3Parser) RuleEntry() (localctx IRuleEntryContext) {
 
 	if _la == grulev3ParserSALIENCE {
 		{
-			p.SetState(79)
+			p.SetState(100)
 			p.Salience()
 		}
 
 	}
+	p.SetState(106)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == grulev3ParserSIMPLENAME {
+		{
+			p.SetState(103)
+			p.RuleAttribute()
+		}
+
+		p.SetState(108)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
 	{
-		p.SetState(82)
+		p.SetState(109)
 		p.Match(grulev3ParserLR_BRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -699,15 +924,15 @@ func (p *grulev3Parser) RuleEntry() (localctx IRuleEntryContext) {
 		}
 	}
 	{
-		p.SetState(83)
+		p.SetState(110)
 		p.WhenScope()
 	}
 	{
-		p.SetState(84)
+		p.SetState(111)
 		p.ThenScope()
 	}
 	{
-		p.SetState(85)
+		p.SetState(112)
 		p.Match(grulev3ParserRR_BRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -830,7 +1055,7 @@ func (p *grulev3Parser) Salience() (localctx ISalienceContext) {
 	p.EnterRule(localctx, 4, grulev3ParserRULE_salience)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(87)
+		p.SetState(114)
 		p.Match(grulev3ParserSALIENCE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -838,7 +1063,7 @@ func (p *grulev3Parser) Salience() (localctx ISalienceContext) {
 		}
 	}
 	{
-		p.SetState(88)
+		p.SetState(115)
 		p.IntegerLiteral()
 	}
 
@@ -940,7 +1165,7 @@ func (p *grulev3Parser) RuleName() (localctx IRuleNameContext) {
 	p.EnterRule(localctx, 6, grulev3ParserRULE_ruleName)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(90)
+		p.SetState(117)
 		p.Match(grulev3ParserSIMPLENAME)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1053,7 +1278,7 @@ func (p *grulev3Parser) RuleDescription() (localctx IRuleDescriptionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(92)
+		p.SetState(119)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING) {
@@ -1179,7 +1404,7 @@ func (p *grulev3Parser) WhenScope() (localctx IWhenScopeContext) {
 	p.EnterRule(localctx, 10, grulev3ParserRULE_whenScope)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(94)
+		p.SetState(121)
 		p.Match(grulev3ParserWHEN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1187,7 +1412,7 @@ func (p *grulev3Parser) WhenScope() (localctx IWhenScopeContext) {
 		}
 	}
 	{
-		p.SetState(95)
+		p.SetState(122)
 		p.expression(0)
 	}
 
@@ -1306,7 +1531,7 @@ func (p *grulev3Parser) ThenScope() (localctx IThenScopeContext) {
 	p.EnterRule(localctx, 12, grulev3ParserRULE_thenScope)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(97)
+		p.SetState(124)
 		p.Match(grulev3ParserTHEN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1314,7 +1539,7 @@ func (p *grulev3Parser) ThenScope() (localctx IThenScopeContext) {
 		}
 	}
 	{
-		p.SetState(98)
+		p.SetState(125)
 		p.ThenExpressionList()
 	}
 
@@ -1465,7 +1690,7 @@ func (p *grulev3Parser) ThenExpressionList() (localctx IThenExpressionListContex
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(103)
+	p.SetState(130)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1474,11 +1699,11 @@ func (p *grulev3Parser) ThenExpressionList() (localctx IThenExpressionListContex
 
 	for ok := true; ok; ok = ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&259209881976840) != 0) {
 		{
-			p.SetState(100)
+			p.SetState(127)
 			p.ThenExpression()
 		}
 		{
-			p.SetState(101)
+			p.SetState(128)
 			p.Match(grulev3ParserSEMICOLON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1486,7 +1711,7 @@ func (p *grulev3Parser) ThenExpressionList() (localctx IThenExpressionListContex
 			}
 		}
 
-		p.SetState(105)
+		p.SetState(132)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1619,24 +1844,24 @@ func (s *ThenExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface
 func (p *grulev3Parser) ThenExpression() (localctx IThenExpressionContext) {
 	localctx = NewThenExpressionContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 16, grulev3ParserRULE_thenExpression)
-	p.SetState(109)
+	p.SetState(136)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 4, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(107)
+			p.SetState(134)
 			p.Assignment()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(108)
+			p.SetState(135)
 			p.expressionAtom(0)
 		}
 
@@ -1798,11 +2023,11 @@ func (p *grulev3Parser) Assignment() (localctx IAssignmentContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(111)
+		p.SetState(138)
 		p.variable(0)
 	}
 	{
-		p.SetState(112)
+		p.SetState(139)
 		_la = p.GetTokenStream().LA(1)
 
 		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2080374784) != 0) {
@@ -1813,7 +2038,7 @@ func (p *grulev3Parser) Assignment() (localctx IAssignmentContext) {
 		}
 	}
 	{
-		p.SetState(113)
+		p.SetState(140)
 		p.expression(0)
 	}
 
@@ -2083,15 +2308,15 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(124)
+	p.SetState(151)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
-		p.SetState(117)
+		p.SetState(144)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2100,7 +2325,7 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 
 		if _la == grulev3ParserNEGATION {
 			{
-				p.SetState(116)
+				p.SetState(143)
 				p.Match(grulev3ParserNEGATION)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -2110,7 +2335,7 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 
 		}
 		{
-			p.SetState(119)
+			p.SetState(146)
 			p.Match(grulev3ParserLR_BRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2118,11 +2343,11 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 			}
 		}
 		{
-			p.SetState(120)
+			p.SetState(147)
 			p.expression(0)
 		}
 		{
-			p.SetState(121)
+			p.SetState(148)
 			p.Match(grulev3ParserRR_BRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2132,7 +2357,7 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 
 	case 2:
 		{
-			p.SetState(123)
+			p.SetState(150)
 			p.expressionAtom(0)
 		}
 
@@ -2140,12 +2365,12 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(148)
+	p.SetState(175)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -2155,100 +2380,100 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(146)
+			p.SetState(173)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(126)
+				p.SetState(153)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(127)
+					p.SetState(154)
 					p.MulDivOperators()
 				}
 				{
-					p.SetState(128)
+					p.SetState(155)
 					p.expression(8)
 				}
 
 			case 2:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(130)
+				p.SetState(157)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(131)
+					p.SetState(158)
 					p.AddMinusOperators()
 				}
 				{
-					p.SetState(132)
+					p.SetState(159)
 					p.expression(7)
 				}
 
 			case 3:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(134)
+				p.SetState(161)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(135)
+					p.SetState(162)
 					p.ComparisonOperator()
 				}
 				{
-					p.SetState(136)
+					p.SetState(163)
 					p.expression(6)
 				}
 
 			case 4:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(138)
+				p.SetState(165)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(139)
+					p.SetState(166)
 					p.AndLogicOperator()
 				}
 				{
-					p.SetState(140)
+					p.SetState(167)
 					p.expression(5)
 				}
 
 			case 5:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expression)
-				p.SetState(142)
+				p.SetState(169)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(143)
+					p.SetState(170)
 					p.OrLogicOperator()
 				}
 				{
-					p.SetState(144)
+					p.SetState(171)
 					p.expression(4)
 				}
 
@@ -2257,12 +2482,12 @@ func (p *grulev3Parser) expression(_p int) (localctx IExpressionContext) {
 			}
 
 		}
-		p.SetState(150)
+		p.SetState(177)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -2378,7 +2603,7 @@ func (p *grulev3Parser) MulDivOperators() (localctx IMulDivOperatorsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(151)
+		p.SetState(178)
 		_la = p.GetTokenStream().LA(1)
 
 		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&112) != 0) {
@@ -2504,7 +2729,7 @@ func (p *grulev3Parser) AddMinusOperators() (localctx IAddMinusOperatorsContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(153)
+		p.SetState(180)
 		_la = p.GetTokenStream().LA(1)
 
 		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&206158430220) != 0) {
@@ -2640,7 +2865,7 @@ func (p *grulev3Parser) ComparisonOperator() (localctx IComparisonOperatorContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(155)
+		p.SetState(182)
 		_la = p.GetTokenStream().LA(1)
 
 		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&66605547520) != 0) {
@@ -2749,7 +2974,7 @@ func (p *grulev3Parser) AndLogicOperator() (localctx IAndLogicOperatorContext) {
 	p.EnterRule(localctx, 28, grulev3ParserRULE_andLogicOperator)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(157)
+		p.SetState(184)
 		p.Match(grulev3ParserAND)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2855,7 +3080,7 @@ func (p *grulev3Parser) OrLogicOperator() (localctx IOrLogicOperatorContext) {
 	p.EnterRule(localctx, 30, grulev3ParserRULE_orLogicOperator)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(159)
+		p.SetState(186)
 		p.Match(grulev3ParserOR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3091,34 +3316,34 @@ func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(167)
+	p.SetState(194)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(162)
+			p.SetState(189)
 			p.Constant()
 		}
 
 	case 2:
 		{
-			p.SetState(163)
+			p.SetState(190)
 			p.variable(0)
 		}
 
 	case 3:
 		{
-			p.SetState(164)
+			p.SetState(191)
 			p.FunctionCall()
 		}
 
 	case 4:
 		{
-			p.SetState(165)
+			p.SetState(192)
 			p.Match(grulev3ParserNEGATION)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3126,7 +3351,7 @@ func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext)
 			}
 		}
 		{
-			p.SetState(166)
+			p.SetState(193)
 			p.expressionAtom(1)
 		}
 
@@ -3134,12 +3359,12 @@ func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext)
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(177)
+	p.SetState(204)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -3149,52 +3374,52 @@ func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext)
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(175)
+			p.SetState(202)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 13, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
-				p.SetState(169)
+				p.SetState(196)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(170)
+					p.SetState(197)
 					p.MethodCall()
 				}
 
 			case 2:
 				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
-				p.SetState(171)
+				p.SetState(198)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(172)
+					p.SetState(199)
 					p.MemberVariable()
 				}
 
 			case 3:
 				localctx = NewExpressionAtomContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_expressionAtom)
-				p.SetState(173)
+				p.SetState(200)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(174)
+					p.SetState(201)
 					p.ArrayMapSelector()
 				}
 
@@ -3203,12 +3428,12 @@ func (p *grulev3Parser) expressionAtom(_p int) (localctx IExpressionAtomContext)
 			}
 
 		}
-		p.SetState(179)
+		p.SetState(206)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -3378,45 +3603,45 @@ func (s *ConstantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *grulev3Parser) Constant() (localctx IConstantContext) {
 	localctx = NewConstantContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 34, grulev3ParserRULE_constant)
-	p.SetState(185)
+	p.SetState(212)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 15, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(180)
+			p.SetState(207)
 			p.StringLiteral()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(181)
+			p.SetState(208)
 			p.IntegerLiteral()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(182)
+			p.SetState(209)
 			p.FloatLiteral()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(183)
+			p.SetState(210)
 			p.BooleanLiteral()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(184)
+			p.SetState(211)
 			p.Match(grulev3ParserNIL_LITERAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3589,7 +3814,7 @@ func (p *grulev3Parser) variable(_p int) (localctx IVariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(188)
+		p.SetState(215)
 		p.Match(grulev3ParserSIMPLENAME)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3598,12 +3823,12 @@ func (p *grulev3Parser) variable(_p int) (localctx IVariableContext) {
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(196)
+	p.SetState(223)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -3613,38 +3838,38 @@ func (p *grulev3Parser) variable(_p int) (localctx IVariableContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(194)
+			p.SetState(221)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 13, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewVariableContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_variable)
-				p.SetState(190)
+				p.SetState(217)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(191)
+					p.SetState(218)
 					p.MemberVariable()
 				}
 
 			case 2:
 				localctx = NewVariableContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, grulev3ParserRULE_variable)
-				p.SetState(192)
+				p.SetState(219)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(193)
+					p.SetState(220)
 					p.ArrayMapSelector()
 				}
 
@@ -3653,12 +3878,12 @@ func (p *grulev3Parser) variable(_p int) (localctx IVariableContext) {
 			}
 
 		}
-		p.SetState(198)
+		p.SetState(225)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -3784,7 +4009,7 @@ func (p *grulev3Parser) ArrayMapSelector() (localctx IArrayMapSelectorContext) {
 	p.EnterRule(localctx, 38, grulev3ParserRULE_arrayMapSelector)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(199)
+		p.SetState(226)
 		p.Match(grulev3ParserLS_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3792,11 +4017,11 @@ func (p *grulev3Parser) ArrayMapSelector() (localctx IArrayMapSelectorContext) {
 		}
 	}
 	{
-		p.SetState(200)
+		p.SetState(227)
 		p.expression(0)
 	}
 	{
-		p.SetState(201)
+		p.SetState(228)
 		p.Match(grulev3ParserRS_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3907,7 +4132,7 @@ func (p *grulev3Parser) MemberVariable() (localctx IMemberVariableContext) {
 	p.EnterRule(localctx, 40, grulev3ParserRULE_memberVariable)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(203)
+		p.SetState(230)
 		p.Match(grulev3ParserDOT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3915,7 +4140,7 @@ func (p *grulev3Parser) MemberVariable() (localctx IMemberVariableContext) {
 		}
 	}
 	{
-		p.SetState(204)
+		p.SetState(231)
 		p.Match(grulev3ParserSIMPLENAME)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4050,7 +4275,7 @@ func (p *grulev3Parser) FunctionCall() (localctx IFunctionCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(206)
+		p.SetState(233)
 		p.Match(grulev3ParserSIMPLENAME)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4058,14 +4283,14 @@ func (p *grulev3Parser) FunctionCall() (localctx IFunctionCallContext) {
 		}
 	}
 	{
-		p.SetState(207)
+		p.SetState(234)
 		p.Match(grulev3ParserLR_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(209)
+	p.SetState(236)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4074,13 +4299,13 @@ func (p *grulev3Parser) FunctionCall() (localctx IFunctionCallContext) {
 
 	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&259209881978888) != 0 {
 		{
-			p.SetState(208)
+			p.SetState(235)
 			p.ArgumentList()
 		}
 
 	}
 	{
-		p.SetState(211)
+		p.SetState(238)
 		p.Match(grulev3ParserRR_BRACKET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4203,7 +4428,7 @@ func (p *grulev3Parser) MethodCall() (localctx IMethodCallContext) {
 	p.EnterRule(localctx, 44, grulev3ParserRULE_methodCall)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(213)
+		p.SetState(240)
 		p.Match(grulev3ParserDOT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4211,7 +4436,7 @@ func (p *grulev3Parser) MethodCall() (localctx IMethodCallContext) {
 		}
 	}
 	{
-		p.SetState(214)
+		p.SetState(241)
 		p.FunctionCall()
 	}
 
@@ -4353,10 +4578,10 @@ func (p *grulev3Parser) ArgumentList() (localctx IArgumentListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(216)
+		p.SetState(243)
 		p.expression(0)
 	}
-	p.SetState(221)
+	p.SetState(248)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4365,7 +4590,7 @@ func (p *grulev3Parser) ArgumentList() (localctx IArgumentListContext) {
 
 	for _la == grulev3ParserT__0 {
 		{
-			p.SetState(217)
+			p.SetState(244)
 			p.Match(grulev3ParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4373,11 +4598,11 @@ func (p *grulev3Parser) ArgumentList() (localctx IArgumentListContext) {
 			}
 		}
 		{
-			p.SetState(218)
+			p.SetState(245)
 			p.expression(0)
 		}
 
-		p.SetState(223)
+		p.SetState(250)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4510,24 +4735,24 @@ func (s *FloatLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 func (p *grulev3Parser) FloatLiteral() (localctx IFloatLiteralContext) {
 	localctx = NewFloatLiteralContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 48, grulev3ParserRULE_floatLiteral)
-	p.SetState(226)
+	p.SetState(253)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(224)
+			p.SetState(251)
 			p.DecimalFloatLiteral()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(225)
+			p.SetState(252)
 			p.HexadecimalFloatLiteral()
 		}
 
@@ -4639,7 +4864,7 @@ func (p *grulev3Parser) DecimalFloatLiteral() (localctx IDecimalFloatLiteralCont
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(229)
+	p.SetState(256)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4648,7 +4873,7 @@ func (p *grulev3Parser) DecimalFloatLiteral() (localctx IDecimalFloatLiteralCont
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(228)
+			p.SetState(255)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4658,7 +4883,7 @@ func (p *grulev3Parser) DecimalFloatLiteral() (localctx IDecimalFloatLiteralCont
 
 	}
 	{
-		p.SetState(231)
+		p.SetState(258)
 		p.Match(grulev3ParserDECIMAL_FLOAT_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4770,7 +4995,7 @@ func (p *grulev3Parser) HexadecimalFloatLiteral() (localctx IHexadecimalFloatLit
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(234)
+	p.SetState(261)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4779,7 +5004,7 @@ func (p *grulev3Parser) HexadecimalFloatLiteral() (localctx IHexadecimalFloatLit
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(233)
+			p.SetState(260)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4789,7 +5014,7 @@ func (p *grulev3Parser) HexadecimalFloatLiteral() (localctx IHexadecimalFloatLit
 
 	}
 	{
-		p.SetState(236)
+		p.SetState(263)
 		p.Match(grulev3ParserHEX_FLOAT_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4939,31 +5164,31 @@ func (s *IntegerLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 func (p *grulev3Parser) IntegerLiteral() (localctx IIntegerLiteralContext) {
 	localctx = NewIntegerLiteralContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 54, grulev3ParserRULE_integerLiteral)
-	p.SetState(241)
+	p.SetState(268)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 23, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(238)
+			p.SetState(265)
 			p.DecimalLiteral()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(239)
+			p.SetState(266)
 			p.HexadecimalLiteral()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(240)
+			p.SetState(267)
 			p.OctalLiteral()
 		}
 
@@ -5075,7 +5300,7 @@ func (p *grulev3Parser) DecimalLiteral() (localctx IDecimalLiteralContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(244)
+	p.SetState(271)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5084,7 +5309,7 @@ func (p *grulev3Parser) DecimalLiteral() (localctx IDecimalLiteralContext) {
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(243)
+			p.SetState(270)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5094,7 +5319,7 @@ func (p *grulev3Parser) DecimalLiteral() (localctx IDecimalLiteralContext) {
 
 	}
 	{
-		p.SetState(246)
+		p.SetState(273)
 		p.Match(grulev3ParserDEC_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5206,7 +5431,7 @@ func (p *grulev3Parser) HexadecimalLiteral() (localctx IHexadecimalLiteralContex
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(249)
+	p.SetState(276)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5215,7 +5440,7 @@ func (p *grulev3Parser) HexadecimalLiteral() (localctx IHexadecimalLiteralContex
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(248)
+			p.SetState(275)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5225,7 +5450,7 @@ func (p *grulev3Parser) HexadecimalLiteral() (localctx IHexadecimalLiteralContex
 
 	}
 	{
-		p.SetState(251)
+		p.SetState(278)
 		p.Match(grulev3ParserHEX_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5337,7 +5562,7 @@ func (p *grulev3Parser) OctalLiteral() (localctx IOctalLiteralContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(254)
+	p.SetState(281)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5346,7 +5571,7 @@ func (p *grulev3Parser) OctalLiteral() (localctx IOctalLiteralContext) {
 
 	if _la == grulev3ParserMINUS {
 		{
-			p.SetState(253)
+			p.SetState(280)
 			p.Match(grulev3ParserMINUS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5356,7 +5581,7 @@ func (p *grulev3Parser) OctalLiteral() (localctx IOctalLiteralContext) {
 
 	}
 	{
-		p.SetState(256)
+		p.SetState(283)
 		p.Match(grulev3ParserOCT_LIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5469,7 +5694,7 @@ func (p *grulev3Parser) StringLiteral() (localctx IStringLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(258)
+		p.SetState(285)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING) {
@@ -5585,7 +5810,7 @@ func (p *grulev3Parser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(260)
+		p.SetState(287)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == grulev3ParserTRUE || _la == grulev3ParserFALSE) {
@@ -5609,6 +5834,1034 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IRuleAttributeContext is an interface to support dynamic dispatch.
+type IRuleAttributeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AttributeName() IAttributeNameContext
+	AttributeValue() IAttributeValueContext
+
+	// IsRuleAttributeContext differentiates from other interfaces.
+	IsRuleAttributeContext()
+}
+
+type RuleAttributeContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRuleAttributeContext() *RuleAttributeContext {
+	var p = new(RuleAttributeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleAttribute
+	return p
+}
+
+func InitEmptyRuleAttributeContext(p *RuleAttributeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_ruleAttribute
+}
+
+func (*RuleAttributeContext) IsRuleAttributeContext() {}
+
+func NewRuleAttributeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RuleAttributeContext {
+	var p = new(RuleAttributeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_ruleAttribute
+
+	return p
+}
+
+func (s *RuleAttributeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RuleAttributeContext) AttributeName() IAttributeNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAttributeNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAttributeNameContext)
+}
+
+func (s *RuleAttributeContext) AttributeValue() IAttributeValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAttributeValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAttributeValueContext)
+}
+
+func (s *RuleAttributeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RuleAttributeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RuleAttributeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterRuleAttribute(s)
+	}
+}
+
+func (s *RuleAttributeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitRuleAttribute(s)
+	}
+}
+
+func (s *RuleAttributeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitRuleAttribute(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) RuleAttribute() (localctx IRuleAttributeContext) {
+	localctx = NewRuleAttributeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, grulev3ParserRULE_ruleAttribute)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(290)
+		p.AttributeName()
+	}
+	p.SetState(292)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1649270587392) != 0 {
+		{
+			p.SetState(291)
+			p.AttributeValue()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAttributeNameContext is an interface to support dynamic dispatch.
+type IAttributeNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllSIMPLENAME() []antlr.TerminalNode
+	SIMPLENAME(i int) antlr.TerminalNode
+	AllMINUS() []antlr.TerminalNode
+	MINUS(i int) antlr.TerminalNode
+
+	// IsAttributeNameContext differentiates from other interfaces.
+	IsAttributeNameContext()
+}
+
+type AttributeNameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAttributeNameContext() *AttributeNameContext {
+	var p = new(AttributeNameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_attributeName
+	return p
+}
+
+func InitEmptyAttributeNameContext(p *AttributeNameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_attributeName
+}
+
+func (*AttributeNameContext) IsAttributeNameContext() {}
+
+func NewAttributeNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AttributeNameContext {
+	var p = new(AttributeNameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_attributeName
+
+	return p
+}
+
+func (s *AttributeNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AttributeNameContext) AllSIMPLENAME() []antlr.TerminalNode {
+	return s.GetTokens(grulev3ParserSIMPLENAME)
+}
+
+func (s *AttributeNameContext) SIMPLENAME(i int) antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, i)
+}
+
+func (s *AttributeNameContext) AllMINUS() []antlr.TerminalNode {
+	return s.GetTokens(grulev3ParserMINUS)
+}
+
+func (s *AttributeNameContext) MINUS(i int) antlr.TerminalNode {
+	return s.GetToken(grulev3ParserMINUS, i)
+}
+
+func (s *AttributeNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AttributeNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AttributeNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterAttributeName(s)
+	}
+}
+
+func (s *AttributeNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitAttributeName(s)
+	}
+}
+
+func (s *AttributeNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitAttributeName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) AttributeName() (localctx IAttributeNameContext) {
+	localctx = NewAttributeNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, grulev3ParserRULE_attributeName)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(294)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(299)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == grulev3ParserMINUS {
+		{
+			p.SetState(295)
+			p.Match(grulev3ParserMINUS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(296)
+			p.Match(grulev3ParserSIMPLENAME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(301)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAttributeValueContext is an interface to support dynamic dispatch.
+type IAttributeValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	StringLiteral() IStringLiteralContext
+	BooleanLiteral() IBooleanLiteralContext
+
+	// IsAttributeValueContext differentiates from other interfaces.
+	IsAttributeValueContext()
+}
+
+type AttributeValueContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAttributeValueContext() *AttributeValueContext {
+	var p = new(AttributeValueContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_attributeValue
+	return p
+}
+
+func InitEmptyAttributeValueContext(p *AttributeValueContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_attributeValue
+}
+
+func (*AttributeValueContext) IsAttributeValueContext() {}
+
+func NewAttributeValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AttributeValueContext {
+	var p = new(AttributeValueContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_attributeValue
+
+	return p
+}
+
+func (s *AttributeValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AttributeValueContext) StringLiteral() IStringLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *AttributeValueContext) BooleanLiteral() IBooleanLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBooleanLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBooleanLiteralContext)
+}
+
+func (s *AttributeValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AttributeValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AttributeValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterAttributeValue(s)
+	}
+}
+
+func (s *AttributeValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitAttributeValue(s)
+	}
+}
+
+func (s *AttributeValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitAttributeValue(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) AttributeValue() (localctx IAttributeValueContext) {
+	localctx = NewAttributeValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, grulev3ParserRULE_attributeValue)
+	p.SetState(304)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case grulev3ParserDQUOTA_STRING, grulev3ParserSQUOTA_STRING:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(302)
+			p.StringLiteral()
+		}
+
+	case grulev3ParserTRUE, grulev3ParserFALSE:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(303)
+			p.BooleanLiteral()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAnnotationContext is an interface to support dynamic dispatch.
+type IAnnotationContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AT() antlr.TerminalNode
+	SIMPLENAME() antlr.TerminalNode
+	LR_BRACKET() antlr.TerminalNode
+	StringLiteral() IStringLiteralContext
+	RR_BRACKET() antlr.TerminalNode
+
+	// IsAnnotationContext differentiates from other interfaces.
+	IsAnnotationContext()
+}
+
+type AnnotationContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAnnotationContext() *AnnotationContext {
+	var p = new(AnnotationContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_annotation
+	return p
+}
+
+func InitEmptyAnnotationContext(p *AnnotationContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_annotation
+}
+
+func (*AnnotationContext) IsAnnotationContext() {}
+
+func NewAnnotationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AnnotationContext {
+	var p = new(AnnotationContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_annotation
+
+	return p
+}
+
+func (s *AnnotationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AnnotationContext) AT() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserAT, 0)
+}
+
+func (s *AnnotationContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, 0)
+}
+
+func (s *AnnotationContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
+}
+
+func (s *AnnotationContext) StringLiteral() IStringLiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStringLiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *AnnotationContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *AnnotationContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AnnotationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AnnotationContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterAnnotation(s)
+	}
+}
+
+func (s *AnnotationContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitAnnotation(s)
+	}
+}
+
+func (s *AnnotationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitAnnotation(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) Annotation() (localctx IAnnotationContext) {
+	localctx = NewAnnotationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, grulev3ParserRULE_annotation)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(306)
+		p.Match(grulev3ParserAT)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(307)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(308)
+		p.Match(grulev3ParserLR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(309)
+		p.StringLiteral()
+	}
+	{
+		p.SetState(310)
+		p.Match(grulev3ParserRR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IQueryEntryContext is an interface to support dynamic dispatch.
+type IQueryEntryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SIMPLENAME() antlr.TerminalNode
+	RuleName() IRuleNameContext
+	LR_BRACKET() antlr.TerminalNode
+	RR_BRACKET() antlr.TerminalNode
+	LR_BRACE() antlr.TerminalNode
+	Expression() IExpressionContext
+	RR_BRACE() antlr.TerminalNode
+	QueryParameters() IQueryParametersContext
+	RuleDescription() IRuleDescriptionContext
+
+	// IsQueryEntryContext differentiates from other interfaces.
+	IsQueryEntryContext()
+}
+
+type QueryEntryContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQueryEntryContext() *QueryEntryContext {
+	var p = new(QueryEntryContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_queryEntry
+	return p
+}
+
+func InitEmptyQueryEntryContext(p *QueryEntryContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_queryEntry
+}
+
+func (*QueryEntryContext) IsQueryEntryContext() {}
+
+func NewQueryEntryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryEntryContext {
+	var p = new(QueryEntryContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_queryEntry
+
+	return p
+}
+
+func (s *QueryEntryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryEntryContext) SIMPLENAME() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, 0)
+}
+
+func (s *QueryEntryContext) RuleName() IRuleNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRuleNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRuleNameContext)
+}
+
+func (s *QueryEntryContext) LR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACKET, 0)
+}
+
+func (s *QueryEntryContext) RR_BRACKET() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACKET, 0)
+}
+
+func (s *QueryEntryContext) LR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserLR_BRACE, 0)
+}
+
+func (s *QueryEntryContext) Expression() IExpressionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExpressionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *QueryEntryContext) RR_BRACE() antlr.TerminalNode {
+	return s.GetToken(grulev3ParserRR_BRACE, 0)
+}
+
+func (s *QueryEntryContext) QueryParameters() IQueryParametersContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryParametersContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryParametersContext)
+}
+
+func (s *QueryEntryContext) RuleDescription() IRuleDescriptionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRuleDescriptionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRuleDescriptionContext)
+}
+
+func (s *QueryEntryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QueryEntryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *QueryEntryContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterQueryEntry(s)
+	}
+}
+
+func (s *QueryEntryContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitQueryEntry(s)
+	}
+}
+
+func (s *QueryEntryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitQueryEntry(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) QueryEntry() (localctx IQueryEntryContext) {
+	localctx = NewQueryEntryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, grulev3ParserRULE_queryEntry)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(312)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(313)
+		p.RuleName()
+	}
+	{
+		p.SetState(314)
+		p.Match(grulev3ParserLR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(316)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserSIMPLENAME {
+		{
+			p.SetState(315)
+			p.QueryParameters()
+		}
+
+	}
+	{
+		p.SetState(318)
+		p.Match(grulev3ParserRR_BRACKET)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(320)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == grulev3ParserDQUOTA_STRING || _la == grulev3ParserSQUOTA_STRING {
+		{
+			p.SetState(319)
+			p.RuleDescription()
+		}
+
+	}
+	{
+		p.SetState(322)
+		p.Match(grulev3ParserLR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(323)
+		p.expression(0)
+	}
+	{
+		p.SetState(324)
+		p.Match(grulev3ParserRR_BRACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IQueryParametersContext is an interface to support dynamic dispatch.
+type IQueryParametersContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllSIMPLENAME() []antlr.TerminalNode
+	SIMPLENAME(i int) antlr.TerminalNode
+
+	// IsQueryParametersContext differentiates from other interfaces.
+	IsQueryParametersContext()
+}
+
+type QueryParametersContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQueryParametersContext() *QueryParametersContext {
+	var p = new(QueryParametersContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_queryParameters
+	return p
+}
+
+func InitEmptyQueryParametersContext(p *QueryParametersContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = grulev3ParserRULE_queryParameters
+}
+
+func (*QueryParametersContext) IsQueryParametersContext() {}
+
+func NewQueryParametersContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryParametersContext {
+	var p = new(QueryParametersContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = grulev3ParserRULE_queryParameters
+
+	return p
+}
+
+func (s *QueryParametersContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryParametersContext) AllSIMPLENAME() []antlr.TerminalNode {
+	return s.GetTokens(grulev3ParserSIMPLENAME)
+}
+
+func (s *QueryParametersContext) SIMPLENAME(i int) antlr.TerminalNode {
+	return s.GetToken(grulev3ParserSIMPLENAME, i)
+}
+
+func (s *QueryParametersContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QueryParametersContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *QueryParametersContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.EnterQueryParameters(s)
+	}
+}
+
+func (s *QueryParametersContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(grulev3Listener); ok {
+		listenerT.ExitQueryParameters(s)
+	}
+}
+
+func (s *QueryParametersContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case grulev3Visitor:
+		return t.VisitQueryParameters(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *grulev3Parser) QueryParameters() (localctx IQueryParametersContext) {
+	localctx = NewQueryParametersContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, grulev3ParserRULE_queryParameters)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(326)
+		p.Match(grulev3ParserSIMPLENAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(331)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == grulev3ParserT__0 {
+		{
+			p.SetState(327)
+			p.Match(grulev3ParserT__0)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(328)
+			p.Match(grulev3ParserSIMPLENAME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+		p.SetState(333)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 func (p *grulev3Parser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
 	case 10:
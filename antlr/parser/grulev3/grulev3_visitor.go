@@ -105,4 +105,22 @@ type grulev3Visitor interface {
 
 	// Visit a parse tree produced by grulev3Parser#booleanLiteral.
 	VisitBooleanLiteral(ctx *BooleanLiteralContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#ruleAttribute.
+	VisitRuleAttribute(ctx *RuleAttributeContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#attributeName.
+	VisitAttributeName(ctx *AttributeNameContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#attributeValue.
+	VisitAttributeValue(ctx *AttributeValueContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#annotation.
+	VisitAnnotation(ctx *AnnotationContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#queryEntry.
+	VisitQueryEntry(ctx *QueryEntryContext) interface{}
+
+	// Visit a parse tree produced by grulev3Parser#queryParameters.
+	VisitQueryParameters(ctx *QueryParametersContext) interface{}
 }
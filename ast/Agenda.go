@@ -0,0 +1,89 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import "sync"
+
+// MainAgendaGroup is the agenda group of rules that don't declare a group. It is always at the bottom of the focus stack.
+const MainAgendaGroup = "MAIN"
+
+// NewAgenda create a new Agenda with MainAgendaGroup in focus.
+func NewAgenda() *Agenda {
+
+	return &Agenda{
+		focusStack: []string{MainAgendaGroup},
+	}
+}
+
+// Agenda keeps the focus stack of agenda groups during a single rule execution.
+// Only rules in the agenda group that has the focus are evaluated, when that group
+// has no more rule to execute, the focus goes back to the previous group in the stack.
+type Agenda struct {
+	lock       sync.Mutex
+	focusStack []string
+}
+
+// SetFocus will give the focus to the specified agenda group by putting it on top of the focus stack.
+// Setting the focus to the group that already has it does nothing.
+func (agenda *Agenda) SetFocus(group string) {
+	agenda.lock.Lock()
+	defer agenda.lock.Unlock()
+
+	if len(group) == 0 {
+		group = MainAgendaGroup
+	}
+	if len(agenda.focusStack) > 0 && agenda.focusStack[len(agenda.focusStack)-1] == group {
+
+		return
+	}
+	agenda.focusStack = append(agenda.focusStack, group)
+}
+
+// Focus returns the agenda group that currently has the focus.
+func (agenda *Agenda) Focus() string {
+	agenda.lock.Lock()
+	defer agenda.lock.Unlock()
+
+	if len(agenda.focusStack) == 0 {
+
+		return MainAgendaGroup
+	}
+
+	return agenda.focusStack[len(agenda.focusStack)-1]
+}
+
+// Pop will remove the agenda group that currently has the focus from the focus stack.
+// It returns false if there is no more agenda group left in the stack.
+func (agenda *Agenda) Pop() bool {
+	agenda.lock.Lock()
+	defer agenda.lock.Unlock()
+
+	if len(agenda.focusStack) > 0 {
+		agenda.focusStack = agenda.focusStack[:len(agenda.focusStack)-1]
+	}
+
+	return len(agenda.focusStack) > 0
+}
+
+// FocusStack returns a copy of the focus stack, the group that has the focus is the last element.
+func (agenda *Agenda) FocusStack() []string {
+	agenda.lock.Lock()
+	defer agenda.lock.Unlock()
+
+	stack := make([]string, len(agenda.focusStack))
+	copy(stack, agenda.focusStack)
+
+	return stack
+}
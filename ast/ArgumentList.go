@@ -142,3 +142,24 @@ func (e *ArgumentList) Evaluate(dataContext IDataContext, memory *WorkingMemory)
 
 	return values, nil
 }
+
+// collectFactNames adds the names of the facts these arguments refer to.
+func (e *ArgumentList) collectFactNames(names map[string]bool) {
+	for _, argument := range e.Arguments {
+		argument.collectFactNames(names)
+	}
+}
+
+// collectVariableNames adds the variables the arguments read.
+func (e *ArgumentList) collectVariableNames(names map[string]bool) {
+	for _, argument := range e.Arguments {
+		argument.collectVariableNames(names)
+	}
+}
+
+// collectChangedVariableNames adds the variables the function and method calls within the arguments may change.
+func (e *ArgumentList) collectChangedVariableNames(names map[string]bool) {
+	for _, argument := range e.Arguments {
+		argument.collectChangedVariableNames(names)
+	}
+}
@@ -36,8 +36,6 @@ type ArrayMapSelector struct {
 	GrlText string
 
 	Expression *Expression
-
-	Value reflect.Value
 }
 
 // MakeCatalog will create a catalog entry from ArrayMapSelector node.
@@ -126,15 +124,30 @@ func (e *ArrayMapSelector) SetGrlText(grlText string) {
 // Evaluate will evaluate this AST graph for when scope evaluation
 func (e *ArrayMapSelector) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
 	if e.Expression != nil {
-		val, err := e.Expression.Evaluate(dataContext, memory)
-		if err != nil {
-
-			return val, err
-		}
-		e.Value = val
 
-		return val, err
+		return e.Expression.Evaluate(dataContext, memory)
 	}
 
 	return reflect.ValueOf(nil), fmt.Errorf("array Map Selector contains no selector expression")
 }
+
+// collectFactNames adds the names of the facts this selector refers to.
+func (e *ArrayMapSelector) collectFactNames(names map[string]bool) {
+	if e.Expression != nil {
+		e.Expression.collectFactNames(names)
+	}
+}
+
+// collectVariableNames adds the variables the selector expression reads.
+func (e *ArrayMapSelector) collectVariableNames(names map[string]bool) {
+	if e.Expression != nil {
+		e.Expression.collectVariableNames(names)
+	}
+}
+
+// collectChangedVariableNames adds the variables the function and method calls of the selector expression may change.
+func (e *ArrayMapSelector) collectChangedVariableNames(names map[string]bool) {
+	if e.Expression != nil {
+		e.Expression.collectChangedVariableNames(names)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"github.com/hyperjumptech/grule-rule-engine/ast/unique"
 	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"reflect"
 	"strings"
 )
 
@@ -174,6 +175,35 @@ func (e *Assignment) SetGrlText(grlText string) {
 
 // Execute will execute this graph in the Then scope
 func (e *Assignment) Execute(dataContext IDataContext, memory *WorkingMemory) error {
+	observer := observerOf(dataContext)
+	if observer == nil {
+
+		return e.execute(dataContext, memory)
+	}
+	// the variable may not exist yet, in which case the old value is left invalid.
+	oldValue, err := e.Variable.Evaluate(dataContext, memory)
+	if err != nil {
+		oldValue = reflect.Value{}
+	} else if oldValue.IsValid() && oldValue.CanInterface() {
+		// detach the old value from the fact field that is about to be overwritten.
+		oldValue = reflect.ValueOf(oldValue.Interface())
+	}
+	err = e.execute(dataContext, memory)
+	if err != nil {
+
+		return err
+	}
+	newValue, err := e.Variable.Evaluate(dataContext, memory)
+	if err != nil {
+
+		return err
+	}
+	observer.VariableAssigned(e.Variable.GrlText, oldValue, newValue)
+
+	return nil
+}
+
+func (e *Assignment) execute(dataContext IDataContext, memory *WorkingMemory) error {
 	exprVal, err := e.Expression.Evaluate(dataContext, memory)
 	if err != nil {
 		return err
@@ -224,3 +254,13 @@ func (e *Assignment) Execute(dataContext IDataContext, memory *WorkingMemory) er
 
 	return nil
 }
+
+// collectFactNames adds the names of the facts this assignment refers to.
+func (e *Assignment) collectFactNames(names map[string]bool) {
+	if e.Variable != nil {
+		e.Variable.collectFactNames(names)
+	}
+	if e.Expression != nil {
+		e.Expression.collectFactNames(names)
+	}
+}
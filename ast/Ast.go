@@ -36,6 +36,8 @@ const (
 	EXPRESSIONATOM = "A"
 	// FUNCTIONCALL signature for function call snapshot
 	FUNCTIONCALL = "F"
+	// QUERYENTRY signature for query entry snapshot
+	QUERYENTRY = "Q"
 	// RULEENTRY signature for rule entry snapshot
 	RULEENTRY = "R"
 	// THENEXPRESSION signature for then expression snapshot
@@ -15,6 +15,7 @@
 package ast
 
 import (
+	"fmt"
 	"math"
 	"reflect"
 	"slices"
@@ -41,6 +42,27 @@ type BuiltInFunctions struct {
 	Knowledge     *KnowledgeBase
 	WorkingMemory *WorkingMemory
 	DataContext   IDataContext
+	Agenda        *Agenda
+	Clock         pkg.Clock
+	// Functions are the custom functions given by the engine, they take precedence over the knowledge base's functions.
+	Functions *FunctionRegistry
+	// Justifications ties the facts inserted with InsertLogical to the activation that inserted them.
+	Justifications *Justifications
+}
+
+// lookupFunction returns the custom function of the specified name.
+// This is not exported, as every exported method is a function that can be called from the rules.
+func (gf *BuiltInFunctions) lookupFunction(name string) (*Function, bool) {
+	if function, ok := gf.Functions.Get(name); ok {
+
+		return function, true
+	}
+	if gf.Knowledge != nil {
+
+		return gf.Knowledge.Functions.Get(name)
+	}
+
+	return nil, false
 }
 
 // Complete will cause the engine to stop processing further rules in the current cycle.
@@ -70,8 +92,12 @@ func (gf *BuiltInFunctions) Forget(snippet string) {
 	gf.Knowledge.DataContext.IncrementVariableChangeCount()
 }
 
-// Now is an extension tn time.Now().
+// Now is an extension tn time.Now(). It tells the time of the engine's clock, if there's one.
 func (gf *BuiltInFunctions) Now() time.Time {
+	if gf.Clock != nil {
+
+		return gf.Clock.Now()
+	}
 
 	return time.Now()
 }
@@ -139,6 +165,91 @@ func (gf *BuiltInFunctions) IsZero(i interface{}) bool {
 // Retract will retract a rule from next evaluation cycle.
 func (gf *BuiltInFunctions) Retract(ruleName string) {
 	gf.Knowledge.RetractRule(ruleName)
+	if observer := observerOf(gf.DataContext); observer != nil {
+		observer.RuleRetracted(ruleName)
+	}
+}
+
+// Insert will add a new fact to the data context, the rules can refer to it right away. A retracted fact can be
+// inserted again. A fact that is already in the data context can't be inserted, use Modify to replace it.
+func (gf *BuiltInFunctions) Insert(name string, value interface{}) {
+	if gf.hasFact(name) {
+		panic(fmt.Sprintf("fact %s is already in the data context, use Modify to replace it", name))
+	}
+	gf.addFact(name, value)
+}
+
+// InsertLogical will add a new fact to the data context like Insert, tying it to the activation being executed.
+// The engine retracts the fact as soon as the when scope of the activation's rule entry doesn't hold anymore.
+// A fact inserted logically by the same rule entry is replaced.
+func (gf *BuiltInFunctions) InsertLogical(name string, value interface{}) {
+	if gf.Justifications == nil {
+		panic("logical insertions are only supported while the engine executes the rules")
+	}
+	activation, ok := gf.Justifications.Activation()
+	if !ok {
+		panic(fmt.Sprintf("fact %s can't be inserted logically outside of a rule activation", name))
+	}
+	if justification, ok := gf.Justifications.Get(name); gf.hasFact(name) && (!ok || justification.RuleEntry != activation.RuleEntry) {
+		panic(fmt.Sprintf("fact %s is already in the data context, use Modify to replace it", name))
+	}
+	gf.addFact(name, value)
+	gf.Justifications.Justify(name, activation)
+}
+
+// Modify will replace a fact of the data context by the specified value, the rules referring to that fact are
+// evaluated again. The fact must be in the data context, use Insert to add a new one.
+func (gf *BuiltInFunctions) Modify(name string, value interface{}) {
+	if !gf.hasFact(name) {
+		panic(fmt.Sprintf("fact %s is not in the data context, use Insert to add it", name))
+	}
+	gf.addFact(name, value)
+}
+
+// Delete will retract a fact from the data context. The rules referring to that fact are not candidates anymore,
+// until the fact is inserted again. A fact inserted logically is retracted too.
+func (gf *BuiltInFunctions) Delete(name string) {
+	if name == "DEFUNC" {
+		panic("the DEFUNC fact can't be deleted")
+	}
+	gf.DataContext.Retract(name)
+	if gf.Justifications != nil {
+		gf.Justifications.Remove(name)
+	}
+	gf.factChanged(name)
+}
+
+// hasFact tells whether the named fact is in the data context and not retracted.
+func (gf *BuiltInFunctions) hasFact(name string) bool {
+
+	return gf.DataContext.Get(name) != nil && !gf.DataContext.IsRetracted(name)
+}
+
+// addFact adds the fact to the data context, replacing the fact of that name.
+func (gf *BuiltInFunctions) addFact(name string, value interface{}) {
+	if name == "DEFUNC" {
+		panic("the DEFUNC fact can't be replaced")
+	}
+	if err := gf.DataContext.Add(name, value); err != nil {
+		panic(fmt.Sprintf("error while adding fact %s. got %v", name, err))
+	}
+	gf.factChanged(name)
+}
+
+// factChanged makes the working memory forget every evaluation involving the named fact.
+func (gf *BuiltInFunctions) factChanged(name string) {
+	if gf.WorkingMemory != nil {
+		gf.WorkingMemory.Reset(name)
+	}
+	gf.DataContext.IncrementVariableChangeCount()
+}
+
+// SetFocus will give the focus to the specified agenda group, so its rules are evaluated
+// starting from the next cycle.
+func (gf *BuiltInFunctions) SetFocus(group string) {
+	if gf.Agenda != nil {
+		gf.Agenda.SetFocus(group)
+	}
 }
 
 // GetTimeYear will get the year value of time
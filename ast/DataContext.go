@@ -40,6 +40,7 @@ type DataContext struct {
 	variableChangeCount uint64
 	complete            bool
 	ruleEntry           *RuleEntry
+	observer            ExecutionObserver
 }
 
 func (ctx *DataContext) GetKeys() []string {
@@ -73,6 +74,17 @@ func (ctx *DataContext) SetRuleEntry(re *RuleEntry) {
 	ctx.ruleEntry = re
 }
 
+// GetObserver returns the ExecutionObserver set by the engine, nil if there is none.
+func (ctx *DataContext) GetObserver() ExecutionObserver {
+
+	return ctx.observer
+}
+
+// SetObserver sets the ExecutionObserver notified while the rule entries are executed, nil removes it.
+func (ctx *DataContext) SetObserver(observer ExecutionObserver) {
+	ctx.observer = observer
+}
+
 // IDataContext is the interface for the DataContext struct.
 type IDataContext interface {
 	ResetVariableChangeCount()
@@ -95,6 +107,38 @@ type IDataContext interface {
 	GetRuleEntry() *RuleEntry
 }
 
+// ObservableDataContext is implemented by the data contexts that can hold the ExecutionObserver notified while the
+// rule entries are executed. The engine only traces or reports the assignments and the calls made through such
+// data context, DataContext is one.
+type ObservableDataContext interface {
+	SetObserver(observer ExecutionObserver)
+	GetObserver() ExecutionObserver
+}
+
+// observerOf returns the ExecutionObserver of the data context, nil if it has none or can't hold one.
+func observerOf(dataContext IDataContext) ExecutionObserver {
+	if observable, ok := dataContext.(ObservableDataContext); ok {
+
+		return observable.GetObserver()
+	}
+
+	return nil
+}
+
+// InterceptingDataContext is implemented by the data contexts that make the changes to the facts themselves, instead
+// of the AST nodes, eg. the DryRunDataContext which changes copies of the facts. A data context wrapping another one,
+// like the TransactionDataContext, implements it too and hands the changes to the wrapped data context if it
+// intercepts them, so the wrapper doesn't lose what the wrapped data context does with them.
+type InterceptingDataContext interface {
+	// AssignVariable assigns the value to the variable.
+	AssignVariable(variable *Variable, newVal reflect.Value, memory *WorkingMemory) error
+	// CallFunction calls the function of the expression atom's function call on the value of its expression atom.
+	CallFunction(atom *ExpressionAtom, memory *WorkingMemory) (reflect.Value, model.ValueNode, error)
+	// ExecutingThenScope tells whether a then scope is being executed. The calls made outside of a then scope, eg. by
+	// a when scope, are not expected to change the facts.
+	ExecutingThenScope(executing bool)
+}
+
 // ResetVariableChangeCount will reset the variable change count
 func (ctx *DataContext) ResetVariableChangeCount() {
 	ctx.variableChangeCount = 0
@@ -111,14 +155,15 @@ func (ctx *DataContext) HasVariableChange() bool {
 	return ctx.variableChangeCount > 0
 }
 
-// Add will add struct instance into rule execution context
+// Add will add struct instance into rule execution context, a retracted fact of that key is available again.
 func (ctx *DataContext) Add(key string, obj interface{}) error {
 	ctx.ObjectStore[key] = model.NewGoValueNode(reflect.ValueOf(obj), key)
+	ctx.unretract(key)
 
 	return nil
 }
 
-// AddJSON will add struct instance into rule execution context
+// AddJSON will add struct instance into rule execution context, a retracted fact of that key is available again.
 func (ctx *DataContext) AddJSON(key string, JSON []byte) error {
 	vn, err := model.NewJSONValueNode(string(JSON), key)
 	if err != nil {
@@ -126,6 +171,7 @@ func (ctx *DataContext) AddJSON(key string, JSON []byte) error {
 		return err
 	}
 	ctx.ObjectStore[key] = vn
+	ctx.unretract(key)
 
 	return nil
 }
@@ -142,7 +188,20 @@ func (ctx *DataContext) Get(key string) model.ValueNode {
 
 // Retract temporary retract a fact from data context, making it unavailable for evaluation or modification.
 func (ctx *DataContext) Retract(key string) {
-	ctx.retracted = append(ctx.retracted, key)
+	if !ctx.IsRetracted(key) {
+		ctx.retracted = append(ctx.retracted, key)
+	}
+}
+
+// unretract makes a retracted key fact available again.
+func (ctx *DataContext) unretract(key string) {
+	for i, v := range ctx.retracted {
+		if v == key {
+			ctx.retracted = append(ctx.retracted[:i], ctx.retracted[i+1:]...)
+
+			return
+		}
+	}
 }
 
 // IsRetracted checks if a key fact is currently retracted.
@@ -16,6 +16,9 @@ package ast
 
 import (
 	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 type TestAStruct struct {
@@ -42,3 +45,20 @@ func (tcs *TestCStruct) EchoVariad(ss ...string) int {
 
 	return len(ss)
 }
+
+func TestDataContextRetract(t *testing.T) {
+	dataContext := NewDataContext()
+	assert.NoError(t, dataContext.Add("A", &TestCStruct{}))
+	dataContext.Retract("A")
+	dataContext.Retract("A")
+	dataContext.Retract("B")
+	assert.True(t, dataContext.IsRetracted("A"))
+	assert.Equal(t, []string{"A", "B"}, dataContext.Retracted())
+
+	// adding a fact makes it available again.
+	assert.NoError(t, dataContext.Add("A", &TestCStruct{}))
+	assert.False(t, dataContext.IsRetracted("A"))
+	assert.NoError(t, dataContext.AddJSON("B", []byte(`{"b":1}`)))
+	assert.False(t, dataContext.IsRetracted("B"))
+	assert.Empty(t, dataContext.Retracted())
+}
@@ -0,0 +1,493 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hyperjumptech/grule-rule-engine/model"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+// NewDryRunDataContext creates a data context to dry run the rules against the facts of the specified data context.
+func NewDryRunDataContext(dataContext IDataContext) *DryRunDataContext {
+
+	return &DryRunDataContext{
+		DataContext: NewDataContext().(*DataContext),
+		facts:       dataContext,
+		changeSet:   &ChangeSet{Changes: make([]*Change, 0)},
+	}
+}
+
+// DryRunDataContext is a data context that never changes the facts of the data context it was created from.
+// A fact is copied the first time a rule entry assigns one of its variables, appends to one of its arrays or calls
+// one of its functions, and the rule entries work on the copy from then on. The assignments, the Append calls and the
+// facts inserted, modified or deleted are recorded into a ChangeSet, that can be applied to the facts afterward.
+// A fact's own function changing its copy is recorded as the replacement of the whole fact. The functions called
+// outside of a then scope, eg. by a when scope, are called on the fact or its copy as is, they're not expected to
+// change it.
+type DryRunDataContext struct {
+	// DataContext holds the copies of the facts, the facts added by the rule entries and the state of the execution.
+	*DataContext
+
+	facts     IDataContext
+	changeSet *ChangeSet
+	// executing tells a then scope is being executed.
+	executing bool
+}
+
+// Get returns the copy of the fact if it was copied, or the fact itself.
+func (ctx *DryRunDataContext) Get(key string) model.ValueNode {
+	if valueNode := ctx.DataContext.Get(key); valueNode != nil {
+
+		return valueNode
+	}
+
+	return ctx.facts.Get(key)
+}
+
+// GetKeys returns the keys of the facts, including those added by the rule entries.
+func (ctx *DryRunDataContext) GetKeys() []string {
+	keys := ctx.DataContext.GetKeys()
+	for _, key := range ctx.facts.GetKeys() {
+		if ctx.DataContext.Get(key) == nil {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// Add adds the fact to the copies, it's recorded as the insertion or the replacement of the whole fact.
+func (ctx *DryRunDataContext) Add(key string, obj interface{}) error {
+	if key == "DEFUNC" {
+
+		return ctx.DataContext.Add(key, obj)
+	}
+	path := &factPath{fact: key}
+	oldValue := reflect.Value{}
+	if !ctx.IsRetracted(key) {
+		oldValue = ctx.valueAt(path)
+	}
+	err := ctx.DataContext.Add(key, obj)
+	if err != nil {
+
+		return err
+	}
+	ctx.record(&Change{
+		Path:     key,
+		OldValue: oldValue,
+		NewValue: detachValue(reflect.ValueOf(obj)),
+		path:     path,
+	})
+
+	return nil
+}
+
+// Retract retracts the fact from the copies, it's recorded as the deletion of the fact.
+func (ctx *DryRunDataContext) Retract(key string) {
+	if ctx.IsRetracted(key) {
+
+		return
+	}
+	path := &factPath{fact: key}
+	oldValue := ctx.valueAt(path)
+	ctx.DataContext.Retract(key)
+	ctx.record(&Change{
+		Path:     key,
+		OldValue: oldValue,
+		Deleted:  true,
+		path:     path,
+	})
+}
+
+// IsRetracted tells whether the fact is retracted, either by the rule entries or in the facts if it's not copied.
+func (ctx *DryRunDataContext) IsRetracted(key string) bool {
+	if ctx.DataContext.IsRetracted(key) {
+
+		return true
+	}
+
+	return ctx.DataContext.Get(key) == nil && ctx.facts.IsRetracted(key)
+}
+
+// ChangeSet returns the changes made to the facts so far.
+func (ctx *DryRunDataContext) ChangeSet() *ChangeSet {
+
+	return ctx.changeSet
+}
+
+// copyFact copies the fact before it's changed, unless it's already copied.
+func (ctx *DryRunDataContext) copyFact(key string, memory *WorkingMemory) error {
+	if key == "DEFUNC" || ctx.DataContext.Get(key) != nil {
+
+		return nil
+	}
+	valueNode := ctx.facts.Get(key)
+	if valueNode == nil {
+
+		return nil
+	}
+	if _, ok := valueNode.(*model.JSONValueNode); ok {
+		data, err := json.Marshal(valueNode.Value().Interface())
+		if err != nil {
+
+			return err
+		}
+		err = ctx.DataContext.AddJSON(key, data)
+		if err != nil {
+
+			return err
+		}
+	} else {
+		ctx.DataContext.ObjectStore[key] = model.NewGoValueNode(detachValue(pkg.DeepCopy(valueNode.Value())), key)
+	}
+	// the working memory may still hold the values of the fact itself.
+	memory.ResetAll()
+
+	return nil
+}
+
+// AssignVariable assigns the value to the variable of the fact's copy and records the change.
+func (ctx *DryRunDataContext) AssignVariable(variable *Variable, newVal reflect.Value, memory *WorkingMemory) error {
+	path, err := variable.changePath(ctx, memory)
+	if err != nil {
+
+		return err
+	}
+	if len(path.steps) == 0 {
+		// the whole fact is replaced, Add records it.
+
+		return variable.assign(newVal, ctx, memory)
+	}
+	err = ctx.copyFact(path.fact, memory)
+	if err != nil {
+
+		return err
+	}
+	oldValue := ctx.valueAt(path)
+	err = variable.assign(newVal, ctx, memory)
+	if err != nil {
+
+		return err
+	}
+	ctx.record(&Change{
+		Path:     path.String(),
+		OldValue: oldValue,
+		NewValue: ctx.valueAt(path),
+		path:     path,
+	})
+
+	return nil
+}
+
+// ExecutingThenScope tells whether a then scope is being executed, only the calls it makes are intercepted.
+func (ctx *DryRunDataContext) ExecutingThenScope(executing bool) {
+	ctx.executing = executing
+}
+
+// CallFunction calls the function of the fact's copy. An Append call is recorded as a change of the array, any other
+// call changing the copy as the replacement of the whole fact. Outside of a then scope, the function is just called.
+func (ctx *DryRunDataContext) CallFunction(atom *ExpressionAtom, memory *WorkingMemory) (reflect.Value, model.ValueNode, error) {
+	if !ctx.executing {
+		val, valueNode, _, err := atom.evaluateMethodCall(ctx, memory)
+
+		return val, valueNode, err
+	}
+	path, err := atom.ExpressionAtom.changePath(ctx, memory)
+	if err != nil {
+
+		return reflect.Value{}, nil, err
+	}
+	if path == nil {
+		val, valueNode, _, err := atom.evaluateMethodCall(ctx, memory)
+
+		return val, valueNode, err
+	}
+	err = ctx.copyFact(path.fact, memory)
+	if err != nil {
+
+		return reflect.Value{}, nil, err
+	}
+	oldValue := ctx.valueAt(path)
+	appending := atom.FunctionCall.FunctionName == "Append"
+	oldFact := reflect.Value{}
+	if !appending {
+		oldFact = ctx.factCopy(path.fact)
+	}
+	val, valueNode, args, err := atom.evaluateMethodCall(ctx, memory)
+	if err != nil {
+
+		return reflect.Value{}, nil, err
+	}
+	if appending && valueNode.Parent().IsArray() {
+		ctx.record(&Change{
+			Path:     path.String(),
+			OldValue: oldValue,
+			NewValue: detachValue(valueNode.Parent().Value()),
+			path:     path,
+			appended: append(make([]reflect.Value, 0, len(args)), args...),
+		})
+	} else if newFact := ctx.factCopy(path.fact); oldFact.IsValid() && !reflect.DeepEqual(oldFact.Interface(), newFact.Interface()) {
+		ctx.record(&Change{
+			Path:     path.fact,
+			OldValue: oldFact,
+			NewValue: newFact,
+			path:     &factPath{fact: path.fact},
+			replaced: true,
+		})
+	}
+
+	return val, valueNode, nil
+}
+
+// factCopy returns a deep copy of the fact's copy as it is now, it's invalid if the fact is not copied.
+func (ctx *DryRunDataContext) factCopy(key string) reflect.Value {
+	valueNode := ctx.DataContext.Get(key)
+	if key == "DEFUNC" || valueNode == nil || !valueNode.Value().IsValid() || !valueNode.Value().CanInterface() {
+
+		return reflect.Value{}
+	}
+
+	return detachValue(pkg.DeepCopy(valueNode.Value()))
+}
+
+// valueAt returns the value of the path in the facts, it's invalid if there is no such value.
+func (ctx *DryRunDataContext) valueAt(path *factPath) reflect.Value {
+	valueNode, err := path.resolve(ctx)
+	if err != nil {
+
+		return reflect.Value{}
+	}
+
+	return detachValue(valueNode.Value())
+}
+
+// record adds the change to the change set, made by the rule entry being executed.
+func (ctx *DryRunDataContext) record(change *Change) {
+	if ruleEntry := ctx.GetRuleEntry(); ruleEntry != nil {
+		change.Rule = ruleEntry.RuleName
+	}
+	ctx.changeSet.Changes = append(ctx.changeSet.Changes, change)
+}
+
+// detachValue detaches the value from the variable holding it, so it doesn't change along with the variable.
+func detachValue(value reflect.Value) reflect.Value {
+	if value.IsValid() && value.CanInterface() && value.Kind() != reflect.Interface {
+
+		return reflect.ValueOf(value.Interface())
+	}
+
+	return value
+}
+
+// ChangeSet holds the changes a dry run made to the facts, in the order they were made.
+type ChangeSet struct {
+	Changes []*Change
+}
+
+// Apply makes the changes to the facts of the data context, in the order they were made by the dry run.
+// It stops at the first change that can't be made.
+func (c *ChangeSet) Apply(dataContext IDataContext) error {
+	for _, change := range c.Changes {
+		err := change.apply(dataContext)
+		if err != nil {
+
+			return fmt.Errorf("can not apply the change of %s made by rule %s. got %w", change.Path, change.Rule, err)
+		}
+	}
+
+	return nil
+}
+
+// Change is a change a rule entry made to a fact during a dry run.
+type Change struct {
+	// Path is the changed variable, eg. `Fact.Items[2]` or `Fact.Tags["color"]`. It's the array for an Append call,
+	// and the fact's name for a fact inserted, modified or deleted as a whole.
+	Path string
+	// OldValue is the value before the change, it's invalid if the variable or the fact didn't exist yet.
+	OldValue reflect.Value
+	// NewValue is the value after the change, it's invalid if the fact was deleted.
+	NewValue reflect.Value
+	// Deleted tells the fact was deleted, eg. by the Delete function.
+	Deleted bool
+	// Rule is the name of the rule entry that made the change.
+	Rule string
+
+	path *factPath
+	// appended are the values an Append call appended to the array, nil for an assignment.
+	appended []reflect.Value
+	// replaced tells a function of the fact changed it, NewValue is then the whole fact.
+	replaced bool
+}
+
+// apply makes the change to the facts of the data context.
+func (c *Change) apply(dataContext IDataContext) error {
+	if c.appended != nil {
+		valueNode, err := c.path.resolve(dataContext)
+		if err != nil {
+
+			return err
+		}
+
+		return valueNode.AppendValue(c.appended)
+	}
+	if c.replaced {
+
+		return c.replaceFact(dataContext)
+	}
+	if c.Deleted {
+		dataContext.Retract(c.path.fact)
+
+		return nil
+	}
+	if len(c.path.steps) == 0 {
+
+		return dataContext.Add(c.path.fact, pkg.ValueToInterface(c.NewValue))
+	}
+	last := len(c.path.steps) - 1
+	parentPath := &factPath{fact: c.path.fact, steps: c.path.steps[:last]}
+	parentNode, err := parentPath.resolve(dataContext)
+	if err != nil {
+
+		return err
+	}
+
+	return c.path.steps[last].set(parentNode, c.NewValue)
+}
+
+// replaceFact replaces the fact by its new value. A fact held by pointer is changed in place, so the change is seen
+// through the pointer given to the data context.
+func (c *Change) replaceFact(dataContext IDataContext) error {
+	valueNode := dataContext.Get(c.path.fact)
+	if valueNode == nil {
+
+		return fmt.Errorf("non existent key %s", c.path.fact)
+	}
+	value := valueNode.Value()
+	if value.Kind() == reflect.Ptr && !value.IsNil() && value.Type() == c.NewValue.Type() {
+		value.Elem().Set(pkg.DeepCopy(c.NewValue).Elem())
+
+		return nil
+	}
+
+	return dataContext.Add(c.path.fact, pkg.ValueToInterface(pkg.DeepCopy(c.NewValue)))
+}
+
+// factPath is the path of a variable in the facts, with its array and map selectors evaluated.
+type factPath struct {
+	fact  string
+	steps []pathStep
+}
+
+// pathStep is either a field or an array or map selector.
+type pathStep struct {
+	field    string
+	selector reflect.Value
+}
+
+// field returns the path of the field.
+func (p *factPath) field(name string) *factPath {
+
+	return p.child(pathStep{field: name})
+}
+
+// selector returns the path of the array or map element.
+func (p *factPath) selector(selValue reflect.Value) *factPath {
+
+	return p.child(pathStep{selector: selValue})
+}
+
+func (p *factPath) child(step pathStep) *factPath {
+	steps := make([]pathStep, len(p.steps), len(p.steps)+1)
+	copy(steps, p.steps)
+
+	return &factPath{fact: p.fact, steps: append(steps, step)}
+}
+
+// resolve returns the value node of the path in the facts of the data context.
+func (p *factPath) resolve(dataContext IDataContext) (model.ValueNode, error) {
+	valueNode := dataContext.Get(p.fact)
+	if valueNode == nil {
+
+		return nil, fmt.Errorf("non existent key %s", p.fact)
+	}
+	for _, step := range p.steps {
+		child, err := step.childOf(valueNode)
+		if err != nil {
+
+			return nil, err
+		}
+		valueNode = child
+	}
+
+	return valueNode, nil
+}
+
+func (p *factPath) String() string {
+	var buff strings.Builder
+	buff.WriteString(p.fact)
+	for _, step := range p.steps {
+		if len(step.field) > 0 {
+			buff.WriteString(".")
+			buff.WriteString(step.field)
+		} else if step.selector.Kind() == reflect.String {
+			buff.WriteString(fmt.Sprintf("[%q]", step.selector.String()))
+		} else {
+			buff.WriteString(fmt.Sprintf("[%v]", step.selector))
+		}
+	}
+
+	return buff.String()
+}
+
+// childOf returns the value node of this step in the parent value node.
+func (s pathStep) childOf(parentNode model.ValueNode) (model.ValueNode, error) {
+	if len(s.field) > 0 {
+
+		return parentNode.GetChildNodeByField(s.field)
+	}
+	if parentNode.IsArray() {
+
+		return parentNode.GetChildNodeByIndex(int(s.selector.Int()))
+	}
+	if parentNode.IsMap() {
+
+		return parentNode.GetChildNodeBySelector(s.selector)
+	}
+
+	return nil, fmt.Errorf("%s is not an array nor map", parentNode.IdentifiedAs())
+}
+
+// set sets the value of this step in the parent value node.
+func (s pathStep) set(parentNode model.ValueNode, value reflect.Value) error {
+	if len(s.field) > 0 {
+
+		return parentNode.SetObjectValueByField(s.field, value)
+	}
+	if parentNode.IsArray() {
+
+		return parentNode.SetArrayValueAt(int(s.selector.Int()), value)
+	}
+	if parentNode.IsMap() {
+
+		return parentNode.SetMapValueAt(s.selector, value)
+	}
+
+	return fmt.Errorf("%s is not an array nor map", parentNode.IdentifiedAs())
+}
@@ -0,0 +1,33 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+	"time"
+)
+
+// ExecutionObserver is notified of what the AST nodes do while the engine evaluates and executes the rule entries.
+// The engine sets it into the IDataContext when it needs to know more than the executed rule entry, eg. to trace an execution.
+type ExecutionObserver interface {
+	// VariableAssigned is called after an assignment changed the variable, with the value it had before and after the assignment.
+	// The old value is invalid if the variable didn't exist yet.
+	VariableAssigned(variable string, oldValue, newValue reflect.Value)
+	// FunctionCalled is called after a function or a fact's method is called, the result is invalid if the call failed
+	// or the function returns nothing.
+	FunctionCalled(function string, args []reflect.Value, result reflect.Value, err error, duration time.Duration)
+	// RuleRetracted is called after a rule entry is retracted using the Retract function.
+	RuleRetracted(ruleName string)
+}
@@ -77,9 +77,6 @@ type Expression struct {
 	ExpressionAtom   *ExpressionAtom
 	Operator         int
 	Negated          bool
-	Value            reflect.Value
-
-	Evaluated bool
 }
 
 // MakeCatalog will create a catalog entry from Expression node.
@@ -277,36 +274,65 @@ func (e *Expression) SetGrlText(grlText string) {
 	e.GrlText = grlText
 }
 
-// Evaluate will evaluate this AST graph for when scope evaluation
+// Complexity counts the operators and atoms this expression is made of.
+// The more complex an expression is, the more specific the condition it describes.
+func (e *Expression) Complexity() int {
+	complexity := 0
+	if e.ExpressionAtom != nil {
+		complexity++
+	}
+	if e.SingleExpression != nil {
+		complexity += e.SingleExpression.Complexity()
+	}
+	if e.LeftExpression != nil && e.RightExpression != nil {
+		complexity += 1 + e.LeftExpression.Complexity() + e.RightExpression.Complexity()
+	}
+
+	return complexity
+}
+
+// Evaluate will evaluate this AST graph for when scope evaluation.
+// The value is taken from the working memory if it's still cached there, otherwise it is cached.
 func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
-	if e.Evaluated == true {
+	if memory == nil {
+
+		return reflect.Value{}, fmt.Errorf("nil working memory is not allowed")
+	}
+	state := memory.expressionState(e)
+	if memory.IsConcurrent() {
+		state.lock.Lock()
+		defer state.lock.Unlock()
+	}
+	if state.evaluated {
 
-		return e.Value, nil
+		return state.value, nil
 	}
+	val, err := e.evaluate(dataContext, memory)
+	if err == nil {
+		state.value = val
+		state.evaluated = true
+	}
+
+	return val, err
+}
+
+// evaluate evaluates this AST graph.
+func (e *Expression) evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
 	if e.ExpressionAtom != nil {
-		val, err := e.ExpressionAtom.Evaluate(dataContext, memory)
-		if err == nil {
-			e.Value = val
-			e.Evaluated = true
-		}
 
-		return val, err
+		return e.ExpressionAtom.Evaluate(dataContext, memory)
 	}
 	if e.SingleExpression != nil {
 		val, err := e.SingleExpression.Evaluate(dataContext, memory)
-		if err == nil {
-			e.Value = val
-			if e.Negated {
-				if e.Value.Kind() == reflect.Bool {
-					e.Value = reflect.ValueOf(!e.Value.Bool())
-				} else {
-					AstLog.Warnf("Expression \"%s\" is a negation to non boolean value, negation is ignored.", e.SingleExpression.GrlText)
-				}
+		if err == nil && e.Negated {
+			if val.Kind() == reflect.Bool {
+				val = reflect.ValueOf(!val.Bool())
+			} else {
+				AstLog.Warnf("Expression \"%s\" is a negation to non boolean value, negation is ignored.", e.SingleExpression.GrlText)
 			}
-			e.Evaluated = true
 		}
 
-		return e.Value, err
+		return val, err
 	}
 	if e.LeftExpression != nil && e.RightExpression != nil {
 		var val reflect.Value
@@ -320,8 +346,6 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 			}
 			val, opErr = pkg.EvaluateLogicSingle(lval)
 			if opErr == nil && !val.Bool() {
-				e.Value = val
-				e.Evaluated = true
 
 				return val, opErr
 			}
@@ -333,8 +357,6 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 			}
 			val, opErr = pkg.EvaluateLogicSingle(lval)
 			if opErr == nil && val.Bool() {
-				e.Value = val
-				e.Evaluated = true
 
 				return val, opErr
 			}
@@ -382,13 +404,57 @@ func (e *Expression) Evaluate(dataContext IDataContext, memory *WorkingMemory) (
 		case OpOr:
 			val, opErr = pkg.EvaluateLogicOr(lval, rval)
 		}
-		if opErr == nil {
-			e.Value = val
-			e.Evaluated = true
-		}
 
 		return val, opErr
 	}
 
 	return reflect.Value{}, nil
 }
+
+// collectFactNames adds the names of the facts this expression refers to.
+func (e *Expression) collectFactNames(names map[string]bool) {
+	if e.LeftExpression != nil {
+		e.LeftExpression.collectFactNames(names)
+	}
+	if e.RightExpression != nil {
+		e.RightExpression.collectFactNames(names)
+	}
+	if e.SingleExpression != nil {
+		e.SingleExpression.collectFactNames(names)
+	}
+	if e.ExpressionAtom != nil {
+		e.ExpressionAtom.collectFactNames(names)
+	}
+}
+
+// collectVariableNames adds the variables this expression reads.
+func (e *Expression) collectVariableNames(names map[string]bool) {
+	if e.LeftExpression != nil {
+		e.LeftExpression.collectVariableNames(names)
+	}
+	if e.RightExpression != nil {
+		e.RightExpression.collectVariableNames(names)
+	}
+	if e.SingleExpression != nil {
+		e.SingleExpression.collectVariableNames(names)
+	}
+	if e.ExpressionAtom != nil {
+		e.ExpressionAtom.collectVariableNames(names)
+	}
+}
+
+// collectChangedVariableNames adds the variables the function and method calls of this expression may change.
+func (e *Expression) collectChangedVariableNames(names map[string]bool) {
+	if e.LeftExpression != nil {
+		e.LeftExpression.collectChangedVariableNames(names)
+	}
+	if e.RightExpression != nil {
+		e.RightExpression.collectChangedVariableNames(names)
+	}
+	if e.SingleExpression != nil {
+		e.SingleExpression.collectChangedVariableNames(names)
+	}
+	if e.ExpressionAtom != nil {
+		e.ExpressionAtom.collectChangedVariableNames(names)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hyperjumptech/grule-rule-engine/model"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hyperjumptech/grule-rule-engine/pkg"
 )
@@ -45,11 +46,6 @@ type ExpressionAtom struct {
 	Negated          bool
 	ExpressionAtom   *ExpressionAtom
 	ArrayMapSelector *ArrayMapSelector
-
-	Value     reflect.Value
-	ValueNode model.ValueNode
-
-	Evaluated bool
 }
 
 // MakeCatalog will create a catalog entry from ExpressionAtom node.
@@ -263,155 +259,305 @@ func (e *ExpressionAtom) SetGrlText(grlText string) {
 	e.GrlText = grlText
 }
 
+// callFunction calls the function of the value node, the ExecutionObserver of the data context is notified of the call.
+func (e *ExpressionAtom) callFunction(dataContext IDataContext, valueNode model.ValueNode, function string, args []reflect.Value) (reflect.Value, error) {
+	observer := observerOf(dataContext)
+	if observer == nil {
+
+		return e.invokeFunction(valueNode, args)
+	}
+	start := time.Now()
+	ret, err := e.invokeFunction(valueNode, args)
+	observer.FunctionCalled(function, args, ret, err, time.Since(start))
+
+	return ret, err
+}
+
+// invokeFunction calls the function of the value node. When the value node holds the built-in functions,
+// the custom functions registered by that name are called instead.
+func (e *ExpressionAtom) invokeFunction(valueNode model.ValueNode, args []reflect.Value) (reflect.Value, error) {
+	if valueNode.Value().IsValid() && valueNode.Value().CanInterface() {
+		if defunc, ok := valueNode.Value().Interface().(*BuiltInFunctions); ok {
+			if function, ok := defunc.lookupFunction(e.FunctionCall.FunctionName); ok {
+
+				return function.Call(args)
+			}
+		}
+	}
+
+	return valueNode.CallFunction(e.FunctionCall.FunctionName, args...)
+}
+
 // Evaluate will evaluate this AST graph for when scope evaluation
-func (e *ExpressionAtom) Evaluate(dataContext IDataContext, memory *WorkingMemory) (val reflect.Value, err error) {
-	if e.Evaluated == true {
+func (e *ExpressionAtom) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
+	val, _, err := e.evaluateValueNode(dataContext, memory)
 
-		return e.Value, nil
+	return val, err
+}
+
+// evaluateValueNode evaluates this AST graph into its value and the value node holding it. The result is taken from
+// the working memory if it's still cached there, otherwise it is cached unless it's the result of a function call.
+func (e *ExpressionAtom) evaluateValueNode(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, model.ValueNode, error) {
+	if memory == nil {
+
+		return reflect.Value{}, nil, fmt.Errorf("nil working memory is not allowed")
+	}
+	state := memory.atomState(e)
+	if memory.IsConcurrent() {
+		state.lock.Lock()
+		defer state.lock.Unlock()
+	}
+	if state.evaluated {
+
+		return state.value, state.valueNode, nil
+	}
+	val, valueNode, cached, err := e.evaluate(dataContext, memory)
+	if err == nil {
+		state.value = val
+		state.valueNode = valueNode
+		state.evaluated = cached
 	}
+
+	return val, valueNode, err
+}
+
+// evaluate evaluates this AST graph, it tells whether the result can be cached by the working memory.
+func (e *ExpressionAtom) evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, model.ValueNode, bool, error) {
 	if e.Constant != nil {
 		val, err := e.Constant.Evaluate(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
-		e.Value = val
-		e.ValueNode = model.NewGoValueNode(val, fmt.Sprintf("%s->%s", val.Type().String(), val.String()))
-		e.Evaluated = true
 
-		return val, err
+		return val, model.NewGoValueNode(val, fmt.Sprintf("%s->%s", val.Type().String(), val.String())), true, nil
 	}
 	if e.Variable != nil {
-		val, err := e.Variable.Evaluate(dataContext, memory)
+		valueNode, err := e.Variable.evaluateValueNode(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
-		//t, _ := e.Variable.ValueNode.GetType()
-		e.Value = val
-		e.ValueNode = e.Variable.ValueNode
-		e.Evaluated = true
 
-		return val, err
+		return valueNode.Value(), valueNode, true, nil
 	}
 	if e.ExpressionAtom == nil && e.FunctionCall != nil {
 		valueNode := dataContext.Get("DEFUNC")
 		args, err := e.FunctionCall.EvaluateArgumentList(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
-		ret, err := valueNode.CallFunction(e.FunctionCall.FunctionName, args...)
+		ret, err := e.callFunction(dataContext, valueNode, e.FunctionCall.FunctionName, args)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
-		e.Value = ret
-		e.ValueNode = model.NewGoValueNode(e.Value, fmt.Sprintf("%s()", e.FunctionCall.FunctionName))
-		// e.Evaluated = true
 
-		return ret, err
+		// the functions are called again on each evaluation, eg. Now() changes over time.
+		return ret, model.NewGoValueNode(ret, fmt.Sprintf("%s()", e.FunctionCall.FunctionName)), false, nil
 	}
 	if e.ExpressionAtom != nil && e.FunctionCall == nil && len(e.VariableName) == 0 && e.ArrayMapSelector == nil {
-		val, err := e.ExpressionAtom.Evaluate(dataContext, memory)
+		val, valueNode, err := e.ExpressionAtom.evaluateValueNode(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
-		e.Value = val
-		e.ValueNode = e.ExpressionAtom.ValueNode
 		if e.Negated {
-			if e.Value.Kind() == reflect.Bool {
-				e.Value = reflect.ValueOf(!e.Value.Bool())
-				e.ValueNode = model.NewGoValueNode(e.Value, fmt.Sprintf("!%s", e.GrlText))
+			if val.Kind() == reflect.Bool {
+				val = reflect.ValueOf(!val.Bool())
+				valueNode = model.NewGoValueNode(val, fmt.Sprintf("!%s", e.GrlText))
 			} else {
 				AstLog.Warnf("Expression \"%s\" is a negation to non boolean value, negation is ignored.", e.ExpressionAtom.GrlText)
 			}
 		}
 
-		e.Evaluated = true
-
-		return e.Value, err
+		return val, valueNode, true, nil
 	}
 	if e.ExpressionAtom != nil && e.FunctionCall != nil {
-		_, err := e.ExpressionAtom.Evaluate(dataContext, memory)
-		if err != nil {
-
-			return reflect.ValueOf(nil), err
-		}
-
-		args, err := e.FunctionCall.EvaluateArgumentList(dataContext, memory)
-		if err != nil {
-
-			return reflect.ValueOf(nil), err
-		}
-
-		retVal, err := e.ExpressionAtom.ValueNode.CallFunction(e.FunctionCall.FunctionName, args...)
-		if err != nil {
-
-			return reflect.ValueOf(nil), err
-		}
-
-		if retVal.IsValid() {
-			e.Value = retVal
+		var val reflect.Value
+		var valueNode model.ValueNode
+		var err error
+		if intercepting, ok := dataContext.(InterceptingDataContext); ok {
+			val, valueNode, err = intercepting.CallFunction(e, memory)
+		} else {
+			val, valueNode, _, err = e.evaluateMethodCall(dataContext, memory)
 		}
-		e.ValueNode = e.ExpressionAtom.ValueNode.ContinueWithValue(retVal, e.FunctionCall.FunctionName)
-		e.Evaluated = true
 
-		return e.Value, nil
+		return val, valueNode, err == nil, err
 	}
 	if e.ExpressionAtom != nil && len(e.VariableName) > 0 {
-		_, err := e.ExpressionAtom.Evaluate(dataContext, memory)
+		_, atomNode, err := e.ExpressionAtom.evaluateValueNode(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
-		valueNode, err := e.ExpressionAtom.ValueNode.GetChildNodeByField(e.VariableName)
+		valueNode, err := atomNode.GetChildNodeByField(e.VariableName)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
-		e.ValueNode = valueNode
-		e.Value = valueNode.Value()
-		e.Evaluated = true
 
-		return e.Value, nil
+		return valueNode.Value(), valueNode, true, nil
 	}
 	if e.ExpressionAtom != nil && e.ArrayMapSelector != nil && len(e.VariableName) == 0 {
-
-		_, err := e.ExpressionAtom.Evaluate(dataContext, memory)
+		_, atomNode, err := e.ExpressionAtom.evaluateValueNode(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
 		selValue, err := e.ArrayMapSelector.Evaluate(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return reflect.Value{}, nil, false, err
 		}
 		var valueNode model.ValueNode
-		if e.ExpressionAtom.ValueNode.IsArray() {
-			valueNode, err = e.ExpressionAtom.ValueNode.GetChildNodeByIndex(int(selValue.Int()))
+		if atomNode.IsArray() {
+			valueNode, err = atomNode.GetChildNodeByIndex(int(selValue.Int()))
 			if err != nil {
 
-				return reflect.Value{}, err
+				return reflect.Value{}, nil, false, err
 			}
-		} else if e.ExpressionAtom.ValueNode.IsMap() {
-			valueNode, err = e.ExpressionAtom.ValueNode.GetChildNodeBySelector(selValue)
+		} else if atomNode.IsMap() {
+			valueNode, err = atomNode.GetChildNodeBySelector(selValue)
 			if err != nil {
 
-				return reflect.Value{}, err
+				return reflect.Value{}, nil, false, err
 			}
 		} else {
 
-			return reflect.Value{}, fmt.Errorf("%s is not an array nor map", e.Variable.ValueNode.IdentifiedAs())
+			return reflect.Value{}, nil, false, fmt.Errorf("%s is not an array nor map", atomNode.IdentifiedAs())
 		}
 
-		e.ValueNode = valueNode
-		e.Value = valueNode.Value()
+		return valueNode.Value(), valueNode, true, nil
+	}
 
-		return e.Value, nil
+	return reflect.Value{}, nil, false, fmt.Errorf("this portion of code should not be reached")
+}
+
+// evaluateMethodCall calls the function of the value this AST graph's expression atom evaluates into,
+// it also returns the arguments of the call.
+func (e *ExpressionAtom) evaluateMethodCall(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, model.ValueNode, []reflect.Value, error) {
+	_, atomNode, err := e.ExpressionAtom.evaluateValueNode(dataContext, memory)
+	if err != nil {
+
+		return reflect.ValueOf(nil), nil, nil, err
 	}
 
-	return reflect.Value{}, fmt.Errorf("this portion of code should not be reached")
+	args, err := e.FunctionCall.EvaluateArgumentList(dataContext, memory)
+	if err != nil {
+
+		return reflect.ValueOf(nil), nil, nil, err
+	}
+
+	retVal, err := e.callFunction(dataContext, atomNode, fmt.Sprintf("%s.%s", e.ExpressionAtom.GrlText, e.FunctionCall.FunctionName), args)
+	if err != nil {
+
+		return reflect.ValueOf(nil), nil, nil, err
+	}
+
+	return retVal, atomNode.ContinueWithValue(retVal, e.FunctionCall.FunctionName), args, nil
+}
+
+// changePath evaluates the array and map selectors of this AST graph into the path of its value in the facts,
+// the path is nil if the value doesn't come from a fact, eg. if it's returned by a function.
+func (e *ExpressionAtom) changePath(dataContext IDataContext, memory *WorkingMemory) (*factPath, error) {
+	if e.Variable != nil {
+
+		return e.Variable.changePath(dataContext, memory)
+	}
+	if e.ExpressionAtom == nil || e.FunctionCall != nil || e.Negated {
+
+		return nil, nil
+	}
+	parentPath, err := e.ExpressionAtom.changePath(dataContext, memory)
+	if err != nil || parentPath == nil {
+
+		return nil, err
+	}
+	if len(e.VariableName) > 0 {
+
+		return parentPath.field(e.VariableName), nil
+	}
+	if e.ArrayMapSelector != nil {
+		selValue, err := e.ArrayMapSelector.Evaluate(dataContext, memory)
+		if err != nil {
+
+			return nil, err
+		}
+
+		return parentPath.selector(selValue), nil
+	}
+
+	return parentPath, nil
+}
+
+// collectFactNames adds the names of the facts this expression atom refers to.
+func (e *ExpressionAtom) collectFactNames(names map[string]bool) {
+	if e.Variable != nil {
+		e.Variable.collectFactNames(names)
+	}
+	if e.FunctionCall != nil {
+		e.FunctionCall.collectFactNames(names)
+	}
+	if e.ExpressionAtom != nil {
+		e.ExpressionAtom.collectFactNames(names)
+	}
+	if e.ArrayMapSelector != nil {
+		e.ArrayMapSelector.collectFactNames(names)
+	}
+}
+
+// collectVariableNames adds the variables this expression atom reads.
+func (e *ExpressionAtom) collectVariableNames(names map[string]bool) {
+	if e.Variable != nil {
+		e.Variable.collectVariableNames(names)
+	}
+	if e.FunctionCall != nil {
+		e.FunctionCall.collectVariableNames(names)
+	}
+	if e.ExpressionAtom != nil {
+		e.ExpressionAtom.collectVariableNames(names)
+	}
+	if e.ArrayMapSelector != nil {
+		e.ArrayMapSelector.collectVariableNames(names)
+	}
+}
+
+// collectReceiverVariableNames adds the variable whose method this expression atom calls, if it's a method call.
+func (e *ExpressionAtom) collectReceiverVariableNames(names map[string]bool) {
+	if e.FunctionCall == nil || e.ExpressionAtom == nil {
+
+		return
+	}
+	for receiver := e.ExpressionAtom; receiver != nil; receiver = receiver.ExpressionAtom {
+		if receiver.Variable != nil {
+			names[receiver.Variable.GrlText] = true
+
+			return
+		}
+	}
+}
+
+// collectChangedVariableNames adds the variables the function and method calls of this expression atom may change:
+// the receivers of the methods and the variables given to them, and the variables the functions change, see
+// FunctionCall.
+func (e *ExpressionAtom) collectChangedVariableNames(names map[string]bool) {
+	if e.FunctionCall != nil {
+		if e.ExpressionAtom != nil {
+			e.collectReceiverVariableNames(names)
+			e.FunctionCall.collectVariableNames(names)
+		} else {
+			e.FunctionCall.collectChangedVariableNames(names)
+		}
+		if e.FunctionCall.ArgumentList != nil {
+			e.FunctionCall.ArgumentList.collectChangedVariableNames(names)
+		}
+	}
+	if e.ExpressionAtom != nil {
+		e.ExpressionAtom.collectChangedVariableNames(names)
+	}
+	if e.ArrayMapSelector != nil {
+		e.ArrayMapSelector.collectChangedVariableNames(names)
+	}
 }
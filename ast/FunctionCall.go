@@ -138,3 +138,60 @@ func (e *FunctionCall) EvaluateArgumentList(dataContext IDataContext, memory *Wo
 
 	return args, nil
 }
+
+// collectFactNames adds the names of the facts the arguments of this function call refer to.
+func (e *FunctionCall) collectFactNames(names map[string]bool) {
+	if e.ArgumentList != nil {
+		e.ArgumentList.collectFactNames(names)
+	}
+}
+
+// collectVariableNames adds the variables the arguments of this function call read.
+func (e *FunctionCall) collectVariableNames(names map[string]bool) {
+	if e.ArgumentList != nil {
+		e.ArgumentList.collectVariableNames(names)
+	}
+}
+
+// factFunctions are the built-in functions changing the fact, or the variable, named by their first argument.
+var factFunctions = map[string]bool{
+	"Insert":        true,
+	"InsertLogical": true,
+	"Modify":        true,
+	"Delete":        true,
+	"Changed":       true,
+	"Forget":        true,
+}
+
+// collectChangedVariableNames adds the variables this function call may change, when it's not a method call.
+// The built-in functions changing a fact change the fact they name, every variable if the name is not a constant.
+// The other built-in functions change no variable, while a custom function may change the variables it's given.
+func (e *FunctionCall) collectChangedVariableNames(names map[string]bool) {
+	if factFunctions[e.FunctionName] {
+		if name, ok := e.constantStringArgument(0); ok {
+			names[name] = true
+		} else {
+			names[anyVariable] = true
+		}
+
+		return
+	}
+	if _, builtIn := reflect.TypeOf(&BuiltInFunctions{}).MethodByName(e.FunctionName); !builtIn {
+		e.collectVariableNames(names)
+	}
+}
+
+// constantStringArgument returns the argument at that index if it's a string constant.
+func (e *FunctionCall) constantStringArgument(index int) (string, bool) {
+	if e.ArgumentList == nil || len(e.ArgumentList.Arguments) <= index {
+
+		return "", false
+	}
+	atom := e.ArgumentList.Arguments[index].ExpressionAtom
+	if atom == nil || atom.Constant == nil || !atom.Constant.Value.IsValid() || atom.Constant.Value.Kind() != reflect.String {
+
+		return "", false
+	}
+
+	return atom.Constant.Value.String(), true
+}
@@ -0,0 +1,237 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+var (
+	functionNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	errorType         = reflect.TypeOf((*error)(nil)).Elem()
+	builtInType       = reflect.TypeOf(&BuiltInFunctions{})
+)
+
+// NewFunctionRegistry creates an empty FunctionRegistry.
+func NewFunctionRegistry() *FunctionRegistry {
+
+	return &FunctionRegistry{
+		functions: make(map[string]*Function),
+	}
+}
+
+// FunctionRegistry holds the custom functions that can be called from the rules without a fact, like the built-in functions.
+// It is safe to use concurrently.
+type FunctionRegistry struct {
+	lock      sync.RWMutex
+	functions map[string]*Function
+}
+
+// Function is a Go function registered into a FunctionRegistry.
+type Function struct {
+	Name  string
+	value reflect.Value
+}
+
+// Register will add a Go function that can be called from the rules by the specified name.
+// The function must return nothing, a single value, or a value and an error. When the returned error is not nil, the
+// rule evaluation or execution fails. The name can't be the name of a built-in function, and registering the same name
+// again replaces the function.
+func (r *FunctionRegistry) Register(name string, function interface{}) error {
+	if !functionNameRegex.MatchString(name) {
+
+		return fmt.Errorf("invalid function name \"%s\"", name)
+	}
+	if _, ok := builtInType.MethodByName(name); ok {
+
+		return fmt.Errorf("function %s is a built-in function", name)
+	}
+	value := reflect.ValueOf(function)
+	if value.Kind() != reflect.Func || value.IsNil() {
+
+		return fmt.Errorf("function %s is not a function but %T", name, function)
+	}
+	typ := value.Type()
+	switch {
+	case typ.NumOut() > 2:
+
+		return fmt.Errorf("function %s returns %d values, only a value and an error can be returned", name, typ.NumOut())
+	case typ.NumOut() == 2 && !typ.Out(1).Implements(errorType):
+
+		return fmt.Errorf("function %s returns 2 values, the second one must be an error", name)
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.functions[name] = &Function{
+		Name:  name,
+		value: value,
+	}
+
+	return nil
+}
+
+// Unregister will remove the function registered by the specified name.
+func (r *FunctionRegistry) Unregister(name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.functions, name)
+}
+
+// Get returns the function registered by the specified name.
+func (r *FunctionRegistry) Get(name string) (*Function, bool) {
+	if r == nil {
+
+		return nil, false
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	function, ok := r.functions[name]
+
+	return function, ok
+}
+
+// Names returns the sorted names of the registered functions.
+func (r *FunctionRegistry) Names() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	names := make([]string, 0, len(r.functions))
+	for name := range r.functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// CheckArity returns an error if the function can't be called with the specified number of arguments.
+func (f *Function) CheckArity(argumentCount int) error {
+
+	return checkArity(f.Name, f.value.Type(), 0, argumentCount)
+}
+
+// Call will call the function, the arguments are converted to the function's parameter types when possible.
+func (f *Function) Call(args []reflect.Value) (result reflect.Value, err error) {
+	typ := f.value.Type()
+	err = f.CheckArity(len(args))
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var paramType reflect.Type
+		if typ.IsVariadic() && i >= typ.NumIn()-1 {
+			paramType = typ.In(typ.NumIn() - 1).Elem()
+		} else {
+			paramType = typ.In(i)
+		}
+		in[i], err = convertArgument(arg, paramType)
+		if err != nil {
+
+			return reflect.Value{}, fmt.Errorf("argument %d of function %s : %w", i+1, f.Name, err)
+		}
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			result = reflect.Value{}
+			err = fmt.Errorf("error when calling function %s. got %v", f.Name, r)
+		}
+	}()
+	rets := f.value.Call(in)
+	switch len(rets) {
+	case 0:
+
+		return reflect.Value{}, nil
+	case 2:
+		if !rets[1].IsNil() {
+
+			return reflect.Value{}, rets[1].Interface().(error)
+		}
+	}
+
+	return rets[0], nil
+}
+
+// convertArgument converts the argument into the parameter type, eg. the int64 of an integer literal into an int.
+func convertArgument(arg reflect.Value, paramType reflect.Type) (reflect.Value, error) {
+	if !arg.IsValid() {
+
+		return reflect.Zero(paramType), nil
+	}
+	if arg.Type().AssignableTo(paramType) {
+
+		return arg, nil
+	}
+	argKind, paramKind := pkg.GetBaseKind(arg), paramType.Kind()
+	numeric := func(kind reflect.Kind) bool {
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+
+			return true
+		}
+
+		return false
+	}
+	if numeric(argKind) && numeric(paramKind) {
+
+		return arg.Convert(paramType), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("can not use %s as %s", arg.Type().String(), paramType.String())
+}
+
+// checkArity returns an error if a function of the specified type, whose first skipped parameters are not
+// given by the rules, can't be called with the number of arguments.
+func checkArity(name string, typ reflect.Type, skipped, argumentCount int) error {
+	params := typ.NumIn() - skipped
+	if typ.IsVariadic() {
+		if argumentCount < params-1 {
+
+			return fmt.Errorf("function %s needs at least %d arguments, called with %d", name, params-1, argumentCount)
+		}
+
+		return nil
+	}
+	if argumentCount != params {
+
+		return fmt.Errorf("function %s needs %d arguments, called with %d", name, params, argumentCount)
+	}
+
+	return nil
+}
+
+// CheckFunctionCall returns an error if the function called from a rule without a fact is neither a built-in function
+// nor a function of the registry, or can't be called with the number of arguments.
+func (r *FunctionRegistry) CheckFunctionCall(name string, argumentCount int) error {
+	// the receiver is the first parameter of a built-in function's method type.
+	if method, ok := builtInType.MethodByName(name); ok {
+
+		return checkArity(name, method.Type, 1, argumentCount)
+	}
+	if function, ok := r.Get(name); ok {
+
+		return function.CheckArity(argumentCount)
+	}
+
+	return fmt.Errorf("function %s is neither a built-in function nor a registered function", name)
+}
@@ -0,0 +1,91 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFunctionRegistryRegister(t *testing.T) {
+	registry := NewFunctionRegistry()
+	assert.NoError(t, registry.Register("Double", func(i int) int { return i * 2 }))
+	assert.NoError(t, registry.Register("Join", func(sep string, parts ...string) string { return strings.Join(parts, sep) }))
+	assert.NoError(t, registry.Register("Check", func(i int) (bool, error) { return i > 0, nil }))
+	assert.Equal(t, []string{"Check", "Double", "Join"}, registry.Names())
+
+	assert.Error(t, registry.Register("Now", func() int { return 0 }), "built-in function")
+	assert.Error(t, registry.Register("not a name", func() {}))
+	assert.Error(t, registry.Register("NotAFunc", 12))
+	assert.Error(t, registry.Register("TwoValues", func() (int, int) { return 1, 2 }))
+	assert.Error(t, registry.Register("ThreeValues", func() (int, int, error) { return 1, 2, nil }))
+
+	registry.Unregister("Check")
+	_, ok := registry.Get("Check")
+	assert.False(t, ok)
+}
+
+func TestFunctionCall(t *testing.T) {
+	registry := NewFunctionRegistry()
+	assert.NoError(t, registry.Register("Double", func(i int) int { return i * 2 }))
+	assert.NoError(t, registry.Register("Join", func(sep string, parts ...string) string { return strings.Join(parts, sep) }))
+	assert.NoError(t, registry.Register("Fail", func() (int, error) { return 0, errors.New("failed") }))
+	assert.NoError(t, registry.Register("Panic", func() { panic("boom") }))
+
+	double, _ := registry.Get("Double")
+	// integer literals are int64, they are converted into the parameter type.
+	result, err := double.Call([]reflect.Value{reflect.ValueOf(int64(21))})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, result.Interface())
+	_, err = double.Call([]reflect.Value{reflect.ValueOf("21")})
+	assert.Error(t, err)
+	_, err = double.Call(nil)
+	assert.Error(t, err)
+
+	join, _ := registry.Get("Join")
+	result, err = join.Call([]reflect.Value{reflect.ValueOf("-"), reflect.ValueOf("a"), reflect.ValueOf("b")})
+	assert.NoError(t, err)
+	assert.Equal(t, "a-b", result.Interface())
+	assert.NoError(t, join.CheckArity(1))
+	assert.Error(t, join.CheckArity(0))
+
+	fail, _ := registry.Get("Fail")
+	_, err = fail.Call(nil)
+	assert.EqualError(t, err, "failed")
+
+	panics, _ := registry.Get("Panic")
+	_, err = panics.Call(nil)
+	assert.Error(t, err)
+}
+
+func TestCheckFunctionCall(t *testing.T) {
+	registry := NewFunctionRegistry()
+	assert.NoError(t, registry.Register("Double", func(i int) int { return i * 2 }))
+	assert.NoError(t, registry.CheckFunctionCall("Double", 1))
+	assert.Error(t, registry.CheckFunctionCall("Double", 2))
+	assert.NoError(t, registry.CheckFunctionCall("Now", 0))
+	assert.NoError(t, registry.CheckFunctionCall("IsTimeBefore", 2))
+	assert.Error(t, registry.CheckFunctionCall("Retract", 0))
+	assert.Error(t, registry.CheckFunctionCall("Unknown", 0))
+
+	// without registry, only the built-in functions are known.
+	var none *FunctionRegistry
+	assert.NoError(t, none.CheckFunctionCall("Now", 0))
+	assert.Error(t, none.CheckFunctionCall("Double", 1))
+}
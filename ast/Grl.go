@@ -14,19 +14,24 @@
 
 package ast
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 // NewGrl creates new GRL instance
 func NewGrl() *Grl {
 
 	return &Grl{
-		RuleEntries: make(map[string]*RuleEntry, 0),
+		RuleEntries:  make(map[string]*RuleEntry, 0),
+		QueryEntries: make(map[string]*QueryEntry, 0),
 	}
 }
 
-// Grl will contains multiple RuleEntries
+// Grl will contains multiple RuleEntries and QueryEntries
 type Grl struct {
-	RuleEntries map[string]*RuleEntry
+	RuleEntries  map[string]*RuleEntry
+	QueryEntries map[string]*QueryEntry
 }
 
 // GrlReceiver is interface for objects that should hold a GRL, will be called by ANTLR walker.
@@ -47,3 +52,45 @@ func (g *Grl) ReceiveRuleEntry(entry *RuleEntry) error {
 
 	return nil
 }
+
+// OrderedRuleEntries returns the rule entries of this GRL in the order they were declared.
+func (g *Grl) OrderedRuleEntries() []*RuleEntry {
+	entries := make([]*RuleEntry, 0, len(g.RuleEntries))
+	for _, entry := range g.RuleEntries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+
+		return entries[i].Declaration.Before(entries[j].Declaration)
+	})
+
+	return entries
+}
+
+// ReceiveQueryEntry will make this GRL to accept query entries created by ANTLR walker
+func (g *Grl) ReceiveQueryEntry(entry *QueryEntry) error {
+	if g.QueryEntries == nil {
+		g.QueryEntries = make(map[string]*QueryEntry)
+	}
+	if _, ok := g.QueryEntries[entry.QueryName]; ok {
+
+		return fmt.Errorf("duplicate query entry %s", entry.QueryName)
+	}
+	g.QueryEntries[entry.QueryName] = entry
+
+	return nil
+}
+
+// OrderedQueryEntries returns the query entries of this GRL in the order they were declared.
+func (g *Grl) OrderedQueryEntries() []*QueryEntry {
+	entries := make([]*QueryEntry, 0, len(g.QueryEntries))
+	for _, entry := range g.QueryEntries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+
+		return entries[i].Declaration.Before(entries[j].Declaration)
+	})
+
+	return entries
+}
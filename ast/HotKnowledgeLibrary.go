@@ -0,0 +1,296 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// KnowledgeBaseChangeKind tells how the active version of a knowledge base changed.
+type KnowledgeBaseChangeKind string
+
+const (
+	// KnowledgeBasePublished means a new version was published and activated.
+	KnowledgeBasePublished KnowledgeBaseChangeKind = "published"
+	// KnowledgeBaseActivated means an already published version was activated.
+	KnowledgeBaseActivated KnowledgeBaseChangeKind = "activated"
+	// KnowledgeBaseRolledBack means the previously active version was activated back.
+	KnowledgeBaseRolledBack KnowledgeBaseChangeKind = "rolled-back"
+)
+
+// KnowledgeBaseChange tells that the active version of a knowledge base changed.
+type KnowledgeBaseChange struct {
+	Kind KnowledgeBaseChangeKind
+	Name string
+	// PreviousVersion is the version that was active before the change, it's empty if there was none.
+	PreviousVersion string
+	Version         string
+}
+
+// KnowledgeBaseChangeListener is called after the active version of a knowledge base changed.
+type KnowledgeBaseChangeListener func(change KnowledgeBaseChange)
+
+// NewHotKnowledgeLibrary creates an empty HotKnowledgeLibrary.
+func NewHotKnowledgeLibrary() *HotKnowledgeLibrary {
+
+	return &HotKnowledgeLibrary{
+		versions:  make(map[string]map[string]*KnowledgeBase),
+		active:    make(map[string]string),
+		previous:  make(map[string][]string),
+		listeners: make([]KnowledgeBaseChangeListener, 0),
+		pending:   make([]KnowledgeBaseChange, 0),
+	}
+}
+
+// HotKnowledgeLibrary holds the compiled versions of knowledge bases, one of them being the active version of each
+// knowledge base. The rules can be reloaded while executions are running: a new version is built into a
+// KnowledgeLibrary, then published, which atomically makes it the active version. The executions already running
+// keep the session of the version they started with, the new sessions are sessions of the new version.
+// A HotKnowledgeLibrary is safe for concurrent use.
+type HotKnowledgeLibrary struct {
+	lock sync.RWMutex
+	// versions are the compiled knowledge bases, by name and version.
+	versions map[string]map[string]*KnowledgeBase
+	// active is the active version, by name.
+	active map[string]string
+	// previous are the versions that were active before the active one, the last one being the most recent, by name.
+	previous map[string][]string
+
+	listeners []KnowledgeBaseChangeListener
+	// pending are the changes the listeners are not notified of yet, in the order of the changes.
+	pending []KnowledgeBaseChange
+	// notifying tells a goroutine is notifying the listeners of the pending changes.
+	notifying bool
+}
+
+// AddListener adds a listener called after each change of an active version. The listeners are called one at a time,
+// in the order of the changes, without holding the library's lock so they can read the library. A change a listener
+// makes to the library is notified once the listeners returned. A listener that panics is logged, the other listeners
+// are still notified.
+func (lib *HotKnowledgeLibrary) AddListener(listener KnowledgeBaseChangeListener) {
+	lib.lock.Lock()
+	defer lib.lock.Unlock()
+	lib.listeners = append(lib.listeners, listener)
+}
+
+// Publish compiles the knowledge base of the library, identified by its name and version, and makes it the
+// active version. A version can only be published once, its rules can't be changed afterward.
+func (lib *HotKnowledgeLibrary) Publish(source *KnowledgeLibrary, name, version string) error {
+	if source == nil {
+
+		return fmt.Errorf("nil KnowledgeLibrary is not allowed")
+	}
+	compiled, err := source.CompileKnowledgeBase(name, version)
+	if err != nil {
+
+		return err
+	}
+	lib.lock.Lock()
+	if _, ok := lib.versions[name][version]; ok {
+		lib.lock.Unlock()
+
+		return fmt.Errorf("KnowledgeBase %s version %s is already published", name, version)
+	}
+	if lib.versions[name] == nil {
+		lib.versions[name] = make(map[string]*KnowledgeBase)
+	}
+	lib.versions[name][version] = compiled
+
+	lib.activate(KnowledgeBasePublished, name, version)
+
+	return nil
+}
+
+// Activate makes the published version the active version of the knowledge base.
+func (lib *HotKnowledgeLibrary) Activate(name, version string) error {
+	lib.lock.Lock()
+	if _, ok := lib.versions[name][version]; !ok {
+		lib.lock.Unlock()
+
+		return fmt.Errorf("KnowledgeBase %s version %s is not published", name, version)
+	}
+	if lib.active[name] == version {
+		lib.lock.Unlock()
+
+		return nil
+	}
+	lib.activate(KnowledgeBaseActivated, name, version)
+
+	return nil
+}
+
+// Rollback makes the version that was active before the active one the active version again, it returns that version.
+func (lib *HotKnowledgeLibrary) Rollback(name string) (string, error) {
+	lib.lock.Lock()
+	previous := lib.previous[name]
+	if len(previous) == 0 {
+		lib.lock.Unlock()
+
+		return "", fmt.Errorf("KnowledgeBase %s has no previous version to roll back to", name)
+	}
+	version := previous[len(previous)-1]
+	change := KnowledgeBaseChange{
+		Kind:            KnowledgeBaseRolledBack,
+		Name:            name,
+		PreviousVersion: lib.active[name],
+		Version:         version,
+	}
+	lib.previous[name] = previous[:len(previous)-1]
+	lib.active[name] = version
+	lib.notify(change)
+
+	return version, nil
+}
+
+// activate makes the version the active one and notifies the listeners, the lock must be held and is released.
+func (lib *HotKnowledgeLibrary) activate(kind KnowledgeBaseChangeKind, name, version string) {
+	change := KnowledgeBaseChange{
+		Kind:            kind,
+		Name:            name,
+		PreviousVersion: lib.active[name],
+		Version:         version,
+	}
+	if len(change.PreviousVersion) > 0 {
+		lib.previous[name] = append(lib.previous[name], change.PreviousVersion)
+	}
+	lib.active[name] = version
+	lib.notify(change)
+}
+
+// notify queues the change and notifies the listeners of the pending changes, the lock must be held and is released.
+// If another goroutine is already notifying the listeners, it notifies them of this change too, after the earlier ones.
+func (lib *HotKnowledgeLibrary) notify(change KnowledgeBaseChange) {
+	lib.pending = append(lib.pending, change)
+	if lib.notifying {
+		lib.lock.Unlock()
+
+		return
+	}
+	lib.notifying = true
+	for len(lib.pending) > 0 {
+		changes, listeners := lib.pending, lib.listeners
+		lib.pending = make([]KnowledgeBaseChange, 0)
+		lib.lock.Unlock()
+		for _, pending := range changes {
+			for _, listener := range listeners {
+				callListener(listener, pending)
+			}
+		}
+		lib.lock.Lock()
+	}
+	lib.notifying = false
+	lib.lock.Unlock()
+}
+
+// callListener calls the listener, a panic is logged so it doesn't stop the notifications.
+func callListener(listener KnowledgeBaseChangeListener, change KnowledgeBaseChange) {
+	defer func() {
+		if r := recover(); r != nil {
+			AstLog.Errorf("Listener panicked on the %s change of KnowledgeBase %s to version %s. Got %v", change.Kind, change.Name, change.Version, r)
+		}
+	}()
+	listener(change)
+}
+
+// Unpublish removes the published version, it can't be activated nor rolled back to anymore. The active version
+// can't be unpublished. The sessions already created from the version keep working.
+func (lib *HotKnowledgeLibrary) Unpublish(name, version string) error {
+	lib.lock.Lock()
+	defer lib.lock.Unlock()
+	if _, ok := lib.versions[name][version]; !ok {
+
+		return fmt.Errorf("KnowledgeBase %s version %s is not published", name, version)
+	}
+	if lib.active[name] == version {
+
+		return fmt.Errorf("KnowledgeBase %s version %s is active, it can not be unpublished", name, version)
+	}
+	delete(lib.versions[name], version)
+	// the versions around the removed one may become the same, a rollback must still change the active version.
+	previous := make([]string, 0, len(lib.previous[name]))
+	for _, previousVersion := range lib.previous[name] {
+		if previousVersion != version && (len(previous) == 0 || previous[len(previous)-1] != previousVersion) {
+			previous = append(previous, previousVersion)
+		}
+	}
+	for len(previous) > 0 && previous[len(previous)-1] == lib.active[name] {
+		previous = previous[:len(previous)-1]
+	}
+	lib.previous[name] = previous
+
+	return nil
+}
+
+// ActiveVersion returns the active version of the knowledge base, it's false if no version was published.
+func (lib *HotKnowledgeLibrary) ActiveVersion(name string) (string, bool) {
+	lib.lock.RLock()
+	defer lib.lock.RUnlock()
+	version, ok := lib.active[name]
+
+	return version, ok
+}
+
+// Versions returns the sorted published versions of the knowledge base.
+func (lib *HotKnowledgeLibrary) Versions(name string) []string {
+	lib.lock.RLock()
+	defer lib.lock.RUnlock()
+	versions := make([]string, 0, len(lib.versions[name]))
+	for version := range lib.versions[name] {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	return versions
+}
+
+// GetKnowledgeBase returns the compiled knowledge base of the active version. It's a snapshot: it stays the same
+// when another version is activated.
+func (lib *HotKnowledgeLibrary) GetKnowledgeBase(name string) (*KnowledgeBase, error) {
+	lib.lock.RLock()
+	defer lib.lock.RUnlock()
+	version, ok := lib.active[name]
+	if !ok {
+
+		return nil, fmt.Errorf("KnowledgeBase %s is not published", name)
+	}
+
+	return lib.versions[name][version], nil
+}
+
+// GetKnowledgeBaseVersion returns the compiled knowledge base of the published version.
+func (lib *HotKnowledgeLibrary) GetKnowledgeBaseVersion(name, version string) (*KnowledgeBase, error) {
+	lib.lock.RLock()
+	defer lib.lock.RUnlock()
+	compiled, ok := lib.versions[name][version]
+	if !ok {
+
+		return nil, fmt.Errorf("KnowledgeBase %s version %s is not published", name, version)
+	}
+
+	return compiled, nil
+}
+
+// NewSession creates a session of the active version of the knowledge base, to execute it.
+func (lib *HotKnowledgeLibrary) NewSession(name string) (*KnowledgeBase, error) {
+	compiled, err := lib.GetKnowledgeBase(name)
+	if err != nil {
+
+		return nil, err
+	}
+
+	return compiled.NewSession(), nil
+}
@@ -0,0 +1,95 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"sort"
+)
+
+// NewJustifications creates an empty set of justifications.
+func NewJustifications() *Justifications {
+
+	return &Justifications{
+		facts: make(map[string]Justification),
+	}
+}
+
+// Justification is the activation of a rule entry that inserted a fact with the InsertLogical function.
+type Justification struct {
+	RuleEntry *RuleEntry
+	// Cycle is the cycle the activation was executed in.
+	Cycle uint64
+	// Sequence is the number the engine gave to the activation within the execution.
+	Sequence uint64
+	// Order is the position of the rule entry in the evaluation order of the execution.
+	Order int
+}
+
+// Justifications ties the facts inserted with the InsertLogical function to the activation that inserted them.
+// A fact stays in the data context as long as the when scope of the activation's rule entry holds, the engine
+// evaluates it at the start of every cycle and retracts the facts whose justification doesn't hold anymore.
+type Justifications struct {
+	facts map[string]Justification
+	// activation is the activation being executed, nil between the executions.
+	activation *Justification
+}
+
+// Activate sets the activation being executed, the facts it inserts logically are tied to it.
+func (j *Justifications) Activate(activation Justification) {
+	j.activation = &activation
+}
+
+// Deactivate tells the activation is executed, no fact can be inserted logically until the next one.
+func (j *Justifications) Deactivate() {
+	j.activation = nil
+}
+
+// Activation returns the activation being executed, false if there's none.
+func (j *Justifications) Activation() (Justification, bool) {
+	if j.activation == nil {
+
+		return Justification{}, false
+	}
+
+	return *j.activation, true
+}
+
+// Justify ties the fact to the activation that inserted it.
+func (j *Justifications) Justify(fact string, activation Justification) {
+	j.facts[fact] = activation
+}
+
+// Remove unties the fact from its activation, the fact stays in the data context as any other fact.
+func (j *Justifications) Remove(fact string) {
+	delete(j.facts, fact)
+}
+
+// Get returns the activation that inserted the fact, false if the fact was not inserted with InsertLogical.
+func (j *Justifications) Get(fact string) (Justification, bool) {
+	activation, ok := j.facts[fact]
+
+	return activation, ok
+}
+
+// Facts returns the sorted names of the justified facts.
+func (j *Justifications) Facts() []string {
+	facts := make([]string, 0, len(j.facts))
+	for fact := range j.facts {
+		facts = append(facts, fact)
+	}
+	sort.Strings(facts)
+
+	return facts
+}
@@ -30,13 +30,17 @@ import (
 func NewKnowledgeLibrary() *KnowledgeLibrary {
 
 	return &KnowledgeLibrary{
-		Library: make(map[string]*KnowledgeBase),
+		Library:   make(map[string]*KnowledgeBase),
+		Functions: NewFunctionRegistry(),
 	}
 }
 
 // KnowledgeLibrary is a knowledgebase store.
 type KnowledgeLibrary struct {
 	Library map[string]*KnowledgeBase
+	// Functions are the custom functions the rules of this library's knowledge bases can call.
+	// They must be registered before the rules calling them are built.
+	Functions *FunctionRegistry
 }
 
 // GetKnowledgeBase will get the actual KnowledgeBase blue print that will be used to create instances.
@@ -53,6 +57,7 @@ func (lib *KnowledgeLibrary) GetKnowledgeBase(name, version string) *KnowledgeBa
 		Version:       version,
 		RuleEntries:   make(map[string]*RuleEntry),
 		WorkingMemory: NewWorkingMemory(name, version),
+		Functions:     lib.Functions,
 	}
 	lib.Library[GetKnowledgeBaseKey(name, version)] = knowledgeBase
 
@@ -70,6 +75,7 @@ func (lib *KnowledgeLibrary) RemoveRuleEntry(ruleName, name string, version stri
 			lib.Library[nameVersion].RuleEntries[ruleName].Deleted = true
 			delete(lib.Library[nameVersion].RuleEntries, ruleName)
 			lib.Library[nameVersion].RuleEntries[ruleEntry.RuleName] = ruleEntry
+			lib.Library[nameVersion].BuildReteNetwork()
 		}
 	}
 }
@@ -96,6 +102,8 @@ func (lib *KnowledgeLibrary) LoadKnowledgeBaseFromReader(reader io.Reader, overw
 	if err != nil {
 		return nil, err
 	}
+	knowledgeBase.Functions = lib.Functions
+	knowledgeBase.BuildReteNetwork()
 	if overwrite {
 		lib.Library[GetKnowledgeBaseKey(knowledgeBase.Name, knowledgeBase.Version)] = knowledgeBase
 
@@ -137,6 +145,7 @@ func (lib *KnowledgeLibrary) NewKnowledgeBaseInstance(name, version string) (*Kn
 		}
 		if knowledgeBase.IsIdentical(newClone) {
 			AstLog.Debugf("Successfully create instance [%s:%s]", newClone.Name, newClone.Version)
+			newClone.BuildReteNetwork()
 
 			return newClone, nil
 		}
@@ -149,6 +158,21 @@ func (lib *KnowledgeLibrary) NewKnowledgeBaseInstance(name, version string) (*Kn
 	return nil, fmt.Errorf("specified knowledge base name and version not exist")
 }
 
+// CompileKnowledgeBase creates an instance of the knowledge base, like NewKnowledgeBaseInstance, whose rule entries
+// can't be changed anymore. Its sessions share its AST and only hold the state of their execution, so they are much
+// cheaper to create than instances. Compile the knowledge base once, then create a session for each execution.
+func (lib *KnowledgeLibrary) CompileKnowledgeBase(name, version string) (*KnowledgeBase, error) {
+	instance, err := lib.NewKnowledgeBaseInstance(name, version)
+	if err != nil {
+
+		return nil, err
+	}
+	// the network is built with the instance, its sessions share it.
+	instance.compiled = true
+
+	return instance, nil
+}
+
 // KnowledgeBase is a collection of RuleEntries. It has a name and version.
 type KnowledgeBase struct {
 	lock          sync.Mutex
@@ -157,6 +181,18 @@ type KnowledgeBase struct {
 	DataContext   IDataContext
 	WorkingMemory *WorkingMemory
 	RuleEntries   map[string]*RuleEntry
+	// QueryEntries are the queries that can be asked to this knowledge base, nil until one is added.
+	QueryEntries map[string]*QueryEntry
+	// Functions are the custom functions the rules can call, shared with the knowledge library.
+	Functions *FunctionRegistry
+
+	// retracted are the names of the rule entries retracted in the current execution.
+	retracted map[string]bool
+	// compiled is true if the rule entries are shared by sessions, so they must not be changed.
+	compiled bool
+	// network is the RETE network of the rule entries, nil until it's built or once the rule entries changed.
+	network     *ReteNetwork
+	networkLock sync.Mutex
 }
 
 // MakeCatalog will create a catalog entry for all AST Nodes under the KnowledgeBase
@@ -179,6 +215,9 @@ func (e *KnowledgeBase) MakeCatalog() *Catalog {
 	for _, v := range e.RuleEntries {
 		v.MakeCatalog(catalog)
 	}
+	for _, v := range e.QueryEntries {
+		v.MakeCatalog(catalog)
+	}
 	e.WorkingMemory.MakeCatalog(catalog)
 
 	return catalog
@@ -210,6 +249,21 @@ func (e *KnowledgeBase) GetSnapshot() string {
 		buffer.WriteString(e.RuleEntries[k].GetSnapshot())
 	}
 	buffer.WriteString("]")
+	if len(e.QueryEntries) > 0 {
+		queryKeys := make([]string, 0, len(e.QueryEntries))
+		for k := range e.QueryEntries {
+			queryKeys = append(queryKeys, k)
+		}
+		sort.Strings(queryKeys)
+		buffer.WriteString("?[")
+		for i, k := range queryKeys {
+			if i > 0 {
+				buffer.WriteString(",")
+			}
+			buffer.WriteString(e.QueryEntries[k].GetSnapshot())
+		}
+		buffer.WriteString("]")
+	}
 
 	return buffer.String()
 }
@@ -220,6 +274,7 @@ func (e *KnowledgeBase) Clone(cloneTable *pkg.CloneTable) (*KnowledgeBase, error
 		Name:        e.Name,
 		Version:     e.Version,
 		RuleEntries: make(map[string]*RuleEntry),
+		Functions:   e.Functions,
 	}
 	if e.RuleEntries != nil {
 		for k, entry := range e.RuleEntries {
@@ -232,6 +287,18 @@ func (e *KnowledgeBase) Clone(cloneTable *pkg.CloneTable) (*KnowledgeBase, error
 			}
 		}
 	}
+	if e.QueryEntries != nil {
+		clone.QueryEntries = make(map[string]*QueryEntry, len(e.QueryEntries))
+		for k, entry := range e.QueryEntries {
+			if cloneTable.IsCloned(entry.AstID) {
+				clone.QueryEntries[k] = cloneTable.Records[entry.AstID].CloneInstance.(*QueryEntry)
+			} else {
+				cloned := entry.Clone(cloneTable)
+				clone.QueryEntries[k] = cloned
+				cloneTable.MarkCloned(entry.AstID, cloned.AstID, entry, cloned)
+			}
+		}
+	}
 	if e.WorkingMemory != nil {
 		wm, err := e.WorkingMemory.Clone(cloneTable)
 		if err != nil {
@@ -243,20 +310,134 @@ func (e *KnowledgeBase) Clone(cloneTable *pkg.CloneTable) (*KnowledgeBase, error
 	return clone, nil
 }
 
+// NewSession creates a session of this knowledge base, to execute it with its own working memory values, retracted
+// rule entries and data context. The sessions share the AST of this knowledge base, which is never changed by the
+// executions, so many sessions can be executed at once, each by a single goroutine. The rule entries of a session
+// can't be changed, and the rule entries of this knowledge base must not be changed while its sessions are in use,
+// which CompileKnowledgeBase guarantees.
+func (e *KnowledgeBase) NewSession() *KnowledgeBase {
+
+	return &KnowledgeBase{
+		Name:          e.Name,
+		Version:       e.Version,
+		WorkingMemory: e.WorkingMemory.NewSession(),
+		RuleEntries:   e.RuleEntries,
+		QueryEntries:  e.QueryEntries,
+		Functions:     e.Functions,
+		compiled:      true,
+		network:       e.ReteNetwork(),
+	}
+}
+
+// IsCompiled returns true if the rule entries of this knowledge base can't be changed,
+// because it was compiled or because it is a session.
+func (e *KnowledgeBase) IsCompiled() bool {
+
+	return e.compiled
+}
+
 // AddRuleEntry add ruleentry into this knowledge base.
 // return an error if a rule entry with the same name already exist in this knowledge base.
 func (e *KnowledgeBase) AddRuleEntry(entry *RuleEntry) error {
 	e.lock.Lock()
 	defer e.lock.Unlock()
+	if e.compiled {
+
+		return fmt.Errorf("knowledge base %s:%s is compiled, rule entry %s can not be added", e.Name, e.Version, entry.RuleName)
+	}
 	if e.ContainsRuleEntry(entry.RuleName) {
 
 		return fmt.Errorf("rule entry %s already exist", entry.RuleName)
 	}
 	e.RuleEntries[entry.RuleName] = entry
+	e.resetReteNetwork()
+
+	return nil
+}
+
+// AddQueryEntry add query entry into this knowledge base.
+// return an error if a query entry with the same name already exist in this knowledge base.
+func (e *KnowledgeBase) AddQueryEntry(entry *QueryEntry) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.compiled {
+
+		return fmt.Errorf("knowledge base %s:%s is compiled, query entry %s can not be added", e.Name, e.Version, entry.QueryName)
+	}
+	if e.QueryEntries == nil {
+		e.QueryEntries = make(map[string]*QueryEntry)
+	}
+	if _, ok := e.QueryEntries[entry.QueryName]; ok {
+
+		return fmt.Errorf("query entry %s already exist", entry.QueryName)
+	}
+	e.QueryEntries[entry.QueryName] = entry
 
 	return nil
 }
 
+// GetQueryEntry returns the query entry with the specified name, false if there is no such query entry.
+func (e *KnowledgeBase) GetQueryEntry(name string) (*QueryEntry, bool) {
+	entry, ok := e.QueryEntries[name]
+
+	return entry, ok
+}
+
+// OrderedRuleEntries returns the rule entries of this knowledge base in the order they were declared.
+// Rule entries from an earlier resource come first, followed by their position within the resource.
+// Rule entries with identical declaration, eg. those added programmatically, are ordered by their name.
+func (e *KnowledgeBase) OrderedRuleEntries() []*RuleEntry {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entries := make([]*RuleEntry, 0, len(e.RuleEntries))
+	for _, entry := range e.RuleEntries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Declaration != entries[j].Declaration {
+			if entries[i].Declaration.Before(entries[j].Declaration) {
+
+				return true
+			}
+			if entries[j].Declaration.Before(entries[i].Declaration) {
+
+				return false
+			}
+		}
+
+		return entries[i].RuleName < entries[j].RuleName
+	})
+
+	return entries
+}
+
+// RuleEntriesWithAnnotation returns the rule entries that have the annotation with the specified key and value,
+// in the order they were declared.
+func (e *KnowledgeBase) RuleEntriesWithAnnotation(key, value string) []*RuleEntry {
+	entries := make([]*RuleEntry, 0)
+	for _, entry := range e.OrderedRuleEntries() {
+		if entry.HasAnnotation(key, value) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// NextResourceIndex returns the resource index to be given to the next resource loaded into this knowledge base.
+func (e *KnowledgeBase) NextResourceIndex() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	next := 0
+	for _, entry := range e.RuleEntries {
+		if entry.Declaration.ResourceIndex >= next {
+			next = entry.Declaration.ResourceIndex + 1
+		}
+	}
+
+	return next
+}
+
 // ContainsRuleEntry will check if a rule with such name is already exist in this knowledge base.
 func (e *KnowledgeBase) ContainsRuleEntry(name string) bool {
 	_, ok := e.RuleEntries[name]
@@ -266,8 +447,21 @@ func (e *KnowledgeBase) ContainsRuleEntry(name string) bool {
 
 // RemoveRuleEntry mark the rule entry as deleted
 func (e *KnowledgeBase) RemoveRuleEntry(name string) {
+	// the network is built once the lock is released, as it reads the ordered rule entries.
+	if e.removeRuleEntry(name) {
+		e.BuildReteNetwork()
+	}
+}
+
+// removeRuleEntry marks the rule entry as deleted, it returns true if it was removed.
+func (e *KnowledgeBase) removeRuleEntry(name string) bool {
 	e.lock.Lock()
 	defer e.lock.Unlock()
+	if e.compiled {
+		AstLog.Errorf("knowledge base %s:%s is compiled, rule entry %s can not be removed", e.Name, e.Version, name)
+
+		return false
+	}
 	if e.ContainsRuleEntry(name) {
 		//mark the rule as deleted and change the rule name to DELETED_XXX_XXXXX to avoid duplicate rule entry issue
 		//Note: This is a workaround, will improve this logic a bit in near future
@@ -276,7 +470,42 @@ func (e *KnowledgeBase) RemoveRuleEntry(name string) {
 		e.RuleEntries[name].Deleted = true
 		delete(e.RuleEntries, name)
 		e.RuleEntries[ruleEntry.RuleName] = ruleEntry
+
+		return true
 	}
+
+	return false
+}
+
+// ReteNetwork returns the RETE network of the when scopes of the rule entries, ordered like OrderedRuleEntries.
+// It's built with the knowledge base, see BuildReteNetwork. It's only built here if rule entries were added since.
+func (e *KnowledgeBase) ReteNetwork() *ReteNetwork {
+	e.networkLock.Lock()
+	defer e.networkLock.Unlock()
+	if e.network == nil {
+		e.network = NewReteNetwork(e.OrderedRuleEntries())
+	}
+
+	return e.network
+}
+
+// BuildReteNetwork builds the RETE network of the when scopes of the rule entries. The RuleBuilder builds it once
+// the rule entries of a resource are added, and the knowledge base instances and the loaded knowledge bases are
+// built with their network, so the executions never wait for it.
+func (e *KnowledgeBase) BuildReteNetwork() *ReteNetwork {
+	network := NewReteNetwork(e.OrderedRuleEntries())
+	e.networkLock.Lock()
+	defer e.networkLock.Unlock()
+	e.network = network
+
+	return network
+}
+
+// resetReteNetwork discards the RETE network, as the rule entries changed.
+func (e *KnowledgeBase) resetReteNetwork() {
+	e.networkLock.Lock()
+	defer e.networkLock.Unlock()
+	e.network = nil
 }
 
 // InitializeContext will initialize this AST graph with data context and working memory before running rule on them.
@@ -286,31 +515,22 @@ func (e *KnowledgeBase) InitializeContext(dataCtx IDataContext) {
 
 // RetractRule will retract the selected rule for execution on the next cycle.
 func (e *KnowledgeBase) RetractRule(ruleName string) {
-	for _, re := range e.RuleEntries {
-		if re.RuleName == ruleName {
-			re.Retracted = true
-		}
+	if e.retracted == nil {
+		e.retracted = make(map[string]bool)
 	}
+	e.retracted[ruleName] = true
 }
 
 // IsRuleRetracted will check if a certain rule denoted by its rule name is currently retracted
 func (e *KnowledgeBase) IsRuleRetracted(ruleName string) bool {
-	for _, re := range e.RuleEntries {
-		if re.RuleName == ruleName {
 
-			return re.Retracted
-		}
-	}
-
-	return false
+	return e.retracted[ruleName]
 }
 
 // Reset will restore all rule in the knowledge
 func (e *KnowledgeBase) Reset() {
-	for _, re := range e.RuleEntries {
-		if re.Retracted {
-			re.Retracted = false
-		}
+	for ruleName := range e.retracted {
+		delete(e.retracted, ruleName)
 	}
 }
 
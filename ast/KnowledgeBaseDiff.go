@@ -0,0 +1,530 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// KnowledgeBaseDiff tells which rules and queries changed between an old and a new knowledge base.
+type KnowledgeBaseDiff struct {
+	// Added are the names of the rules only found in the new knowledge base, sorted.
+	Added []string
+	// Removed are the names of the rules only found in the old knowledge base, sorted.
+	Removed []string
+	// Modified are the rules found in both knowledge bases that changed, sorted by rule name.
+	Modified []*RuleEntryDiff
+
+	// AddedQueries are the names of the queries only found in the new knowledge base, sorted.
+	AddedQueries []string
+	// RemovedQueries are the names of the queries only found in the old knowledge base, sorted.
+	RemovedQueries []string
+	// ModifiedQueries are the queries found in both knowledge bases that changed, sorted by query name.
+	ModifiedQueries []*QueryEntryDiff
+}
+
+// IsEmpty tells whether both knowledge bases have the same rules and queries.
+func (d *KnowledgeBaseDiff) IsEmpty() bool {
+
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0 &&
+		len(d.AddedQueries) == 0 && len(d.RemovedQueries) == 0 && len(d.ModifiedQueries) == 0
+}
+
+// String returns a human readable report of the differences.
+func (d *KnowledgeBaseDiff) String() string {
+	var buff strings.Builder
+	for _, name := range d.Added {
+		buff.WriteString(fmt.Sprintf("+ rule %s\n", name))
+	}
+	for _, name := range d.Removed {
+		buff.WriteString(fmt.Sprintf("- rule %s\n", name))
+	}
+	for _, rule := range d.Modified {
+		buff.WriteString(rule.String())
+	}
+	for _, name := range d.AddedQueries {
+		buff.WriteString(fmt.Sprintf("+ query %s\n", name))
+	}
+	for _, name := range d.RemovedQueries {
+		buff.WriteString(fmt.Sprintf("- query %s\n", name))
+	}
+	for _, query := range d.ModifiedQueries {
+		buff.WriteString(query.String())
+	}
+
+	return buff.String()
+}
+
+// ThenChangeKind tells whether a then statement was added or removed.
+type ThenChangeKind string
+
+const (
+	// ThenStatementAdded means the statement is only found in the new rule.
+	ThenStatementAdded ThenChangeKind = "+"
+	// ThenStatementRemoved means the statement is only found in the old rule.
+	ThenStatementRemoved ThenChangeKind = "-"
+)
+
+// ThenChange is a statement added to or removed from the then scope of a rule.
+type ThenChange struct {
+	Kind ThenChangeKind
+	// Statement is the GRL text of the statement.
+	Statement string
+}
+
+// RuleEntryDiff tells how a rule changed between two knowledge bases.
+type RuleEntryDiff struct {
+	RuleName string
+
+	SalienceChanged bool
+	OldSalience     int
+	NewSalience     int
+
+	DescriptionChanged bool
+	OldDescription     string
+	NewDescription     string
+
+	// WhenChanged tells the when expression changed from the GRL text OldWhen to NewWhen.
+	WhenChanged bool
+	OldWhen     string
+	NewWhen     string
+
+	// ThenChanges are the statements removed from and added to the then scope, in the statements order.
+	ThenChanges []ThenChange
+
+	EnabledChanged bool
+	OldEnabled     bool
+	NewEnabled     bool
+
+	NoLoopChanged bool
+	OldNoLoop     bool
+	NewNoLoop     bool
+
+	LockOnActiveChanged bool
+	OldLockOnActive     bool
+	NewLockOnActive     bool
+
+	// AgendaGroupChanged tells the agenda group changed, an empty agenda group is the MainAgendaGroup.
+	AgendaGroupChanged bool
+	OldAgendaGroup     string
+	NewAgendaGroup     string
+
+	ActivationGroupChanged bool
+	OldActivationGroup     string
+	NewActivationGroup     string
+
+	// DateEffectiveChanged tells the date-effective attribute changed, a zero time means there is none.
+	DateEffectiveChanged bool
+	OldDateEffective     time.Time
+	NewDateEffective     time.Time
+
+	// DateExpiresChanged tells the date-expires attribute changed, a zero time means there is none.
+	DateExpiresChanged bool
+	OldDateExpires     time.Time
+	NewDateExpires     time.Time
+
+	// AnnotationChanges are the annotations added, removed or whose value changed, sorted by key.
+	AnnotationChanges []AnnotationChange
+}
+
+// AnnotationChange is an annotation added to, removed from or changed in a rule.
+type AnnotationChange struct {
+	Key string
+	// Added tells the annotation is only found in the new rule, its old value is empty.
+	Added bool
+	// Removed tells the annotation is only found in the old rule, its new value is empty.
+	Removed  bool
+	OldValue string
+	NewValue string
+}
+
+// String returns a human readable report of the rule changes.
+func (d *RuleEntryDiff) String() string {
+	var buff strings.Builder
+	buff.WriteString(fmt.Sprintf("~ rule %s\n", d.RuleName))
+	if d.SalienceChanged {
+		buff.WriteString(fmt.Sprintf("    salience: %d -> %d\n", d.OldSalience, d.NewSalience))
+	}
+	if d.DescriptionChanged {
+		buff.WriteString(fmt.Sprintf("    description: %q -> %q\n", d.OldDescription, d.NewDescription))
+	}
+	if d.EnabledChanged {
+		buff.WriteString(fmt.Sprintf("    enabled: %t -> %t\n", d.OldEnabled, d.NewEnabled))
+	}
+	if d.NoLoopChanged {
+		buff.WriteString(fmt.Sprintf("    no-loop: %t -> %t\n", d.OldNoLoop, d.NewNoLoop))
+	}
+	if d.LockOnActiveChanged {
+		buff.WriteString(fmt.Sprintf("    lock-on-active: %t -> %t\n", d.OldLockOnActive, d.NewLockOnActive))
+	}
+	if d.AgendaGroupChanged {
+		buff.WriteString(fmt.Sprintf("    group: %q -> %q\n", d.OldAgendaGroup, d.NewAgendaGroup))
+	}
+	if d.ActivationGroupChanged {
+		buff.WriteString(fmt.Sprintf("    activation-group: %q -> %q\n", d.OldActivationGroup, d.NewActivationGroup))
+	}
+	if d.DateEffectiveChanged {
+		buff.WriteString(fmt.Sprintf("    date-effective: %q -> %q\n", formatMetaTime(d.OldDateEffective), formatMetaTime(d.NewDateEffective)))
+	}
+	if d.DateExpiresChanged {
+		buff.WriteString(fmt.Sprintf("    date-expires: %q -> %q\n", formatMetaTime(d.OldDateExpires), formatMetaTime(d.NewDateExpires)))
+	}
+	for _, change := range d.AnnotationChanges {
+		switch {
+		case change.Added:
+			buff.WriteString(fmt.Sprintf("    + @%s(%q)\n", change.Key, change.NewValue))
+		case change.Removed:
+			buff.WriteString(fmt.Sprintf("    - @%s(%q)\n", change.Key, change.OldValue))
+		default:
+			buff.WriteString(fmt.Sprintf("    @%s: %q -> %q\n", change.Key, change.OldValue, change.NewValue))
+		}
+	}
+	if d.WhenChanged {
+		buff.WriteString(fmt.Sprintf("    when: %s -> %s\n", d.OldWhen, d.NewWhen))
+	}
+	if len(d.ThenChanges) > 0 {
+		buff.WriteString("    then:\n")
+		for _, change := range d.ThenChanges {
+			buff.WriteString(fmt.Sprintf("      %s %s\n", change.Kind, change.Statement))
+		}
+	}
+
+	return buff.String()
+}
+
+// QueryEntryDiff tells how a query changed between two knowledge bases.
+type QueryEntryDiff struct {
+	QueryName string
+
+	DescriptionChanged bool
+	OldDescription     string
+	NewDescription     string
+
+	ParametersChanged bool
+	OldParameters     []string
+	NewParameters     []string
+
+	// ExpressionChanged tells the query expression changed from the GRL text OldExpression to NewExpression.
+	ExpressionChanged bool
+	OldExpression     string
+	NewExpression     string
+}
+
+// String returns a human readable report of the query changes.
+func (d *QueryEntryDiff) String() string {
+	var buff strings.Builder
+	buff.WriteString(fmt.Sprintf("~ query %s\n", d.QueryName))
+	if d.DescriptionChanged {
+		buff.WriteString(fmt.Sprintf("    description: %q -> %q\n", d.OldDescription, d.NewDescription))
+	}
+	if d.ParametersChanged {
+		buff.WriteString(fmt.Sprintf("    parameters: (%s) -> (%s)\n", strings.Join(d.OldParameters, ", "), strings.Join(d.NewParameters, ", ")))
+	}
+	if d.ExpressionChanged {
+		buff.WriteString(fmt.Sprintf("    expression: %s -> %s\n", d.OldExpression, d.NewExpression))
+	}
+
+	return buff.String()
+}
+
+// DiffKnowledgeBases compares the rules and queries of an old knowledge base to those of a new one.
+// They are compared using the snapshots of their AST, so formatting changes in the GRL are ignored.
+func DiffKnowledgeBases(oldKB, newKB *KnowledgeBase) *KnowledgeBaseDiff {
+	oldRules := activeRuleEntries(oldKB)
+	newRules := activeRuleEntries(newKB)
+	diff := &KnowledgeBaseDiff{
+		Added:           make([]string, 0),
+		Removed:         make([]string, 0),
+		Modified:        make([]*RuleEntryDiff, 0),
+		AddedQueries:    make([]string, 0),
+		RemovedQueries:  make([]string, 0),
+		ModifiedQueries: make([]*QueryEntryDiff, 0),
+	}
+	for name, newRule := range newRules {
+		oldRule, ok := oldRules[name]
+		if !ok {
+			diff.Added = append(diff.Added, name)
+
+			continue
+		}
+		if ruleDiff := diffRuleEntries(oldRule, newRule); ruleDiff != nil {
+			diff.Modified = append(diff.Modified, ruleDiff)
+		}
+	}
+	for name := range oldRules {
+		if _, ok := newRules[name]; !ok {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.Modified, func(i, j int) bool {
+
+		return diff.Modified[i].RuleName < diff.Modified[j].RuleName
+	})
+	diffQueryEntries(diff, queryEntries(oldKB), queryEntries(newKB))
+
+	return diff
+}
+
+// diffQueryEntries adds the queries added, removed and modified between both knowledge bases to the diff.
+func diffQueryEntries(diff *KnowledgeBaseDiff, oldQueries, newQueries map[string]*QueryEntry) {
+	for name, newQuery := range newQueries {
+		oldQuery, ok := oldQueries[name]
+		if !ok {
+			diff.AddedQueries = append(diff.AddedQueries, name)
+
+			continue
+		}
+		if oldQuery.GetSnapshot() == newQuery.GetSnapshot() {
+			continue
+		}
+		queryDiff := &QueryEntryDiff{
+			QueryName:      name,
+			OldDescription: oldQuery.QueryDescription,
+			NewDescription: newQuery.QueryDescription,
+			OldParameters:  oldQuery.Parameters,
+			NewParameters:  newQuery.Parameters,
+			OldExpression:  queryText(oldQuery),
+			NewExpression:  queryText(newQuery),
+		}
+		queryDiff.DescriptionChanged = queryDiff.OldDescription != queryDiff.NewDescription
+		queryDiff.ParametersChanged = strings.Join(queryDiff.OldParameters, ",") != strings.Join(queryDiff.NewParameters, ",")
+		queryDiff.ExpressionChanged = queryExpressionSnapshot(oldQuery) != queryExpressionSnapshot(newQuery)
+		diff.ModifiedQueries = append(diff.ModifiedQueries, queryDiff)
+	}
+	for name := range oldQueries {
+		if _, ok := newQueries[name]; !ok {
+			diff.RemovedQueries = append(diff.RemovedQueries, name)
+		}
+	}
+	sort.Strings(diff.AddedQueries)
+	sort.Strings(diff.RemovedQueries)
+	sort.Slice(diff.ModifiedQueries, func(i, j int) bool {
+
+		return diff.ModifiedQueries[i].QueryName < diff.ModifiedQueries[j].QueryName
+	})
+}
+
+// DiffCatalogs compares two knowledge bases stored in their binary form,
+// as written by KnowledgeLibrary.StoreKnowledgeBaseToWriter.
+func DiffCatalogs(oldReader, newReader io.Reader) (*KnowledgeBaseDiff, error) {
+	oldKB, err := readCatalogKnowledgeBase(oldReader)
+	if err != nil {
+
+		return nil, fmt.Errorf("error while reading the old knowledge base. got %w", err)
+	}
+	newKB, err := readCatalogKnowledgeBase(newReader)
+	if err != nil {
+
+		return nil, fmt.Errorf("error while reading the new knowledge base. got %w", err)
+	}
+
+	return DiffKnowledgeBases(oldKB, newKB), nil
+}
+
+func readCatalogKnowledgeBase(reader io.Reader) (*KnowledgeBase, error) {
+	catalog := &Catalog{}
+	err := catalog.ReadCatalogFromReader(reader)
+	if err != nil && err != io.EOF {
+
+		return nil, err
+	}
+
+	return catalog.BuildKnowledgeBase()
+}
+
+func activeRuleEntries(knowledgeBase *KnowledgeBase) map[string]*RuleEntry {
+	entries := make(map[string]*RuleEntry)
+	if knowledgeBase == nil {
+
+		return entries
+	}
+	for name, entry := range knowledgeBase.RuleEntries {
+		if !entry.Deleted {
+			entries[name] = entry
+		}
+	}
+
+	return entries
+}
+
+func queryEntries(knowledgeBase *KnowledgeBase) map[string]*QueryEntry {
+	if knowledgeBase == nil {
+
+		return nil
+	}
+
+	return knowledgeBase.QueryEntries
+}
+
+func queryText(query *QueryEntry) string {
+	if query.Expression == nil {
+
+		return ""
+	}
+
+	return query.Expression.GrlText
+}
+
+func queryExpressionSnapshot(query *QueryEntry) string {
+	if query.Expression == nil {
+
+		return ""
+	}
+
+	return query.Expression.GetSnapshot()
+}
+
+// diffRuleEntries returns nil when both rule entries are the same.
+func diffRuleEntries(oldRule, newRule *RuleEntry) *RuleEntryDiff {
+	if oldRule.GetSnapshot() == newRule.GetSnapshot() {
+
+		return nil
+	}
+	diff := &RuleEntryDiff{
+		RuleName:           newRule.RuleName,
+		OldSalience:        oldRule.Salience,
+		NewSalience:        newRule.Salience,
+		OldDescription:     oldRule.RuleDescription,
+		NewDescription:     newRule.RuleDescription,
+		OldWhen:            whenText(oldRule),
+		NewWhen:            whenText(newRule),
+		OldEnabled:         oldRule.IsEnabled(),
+		NewEnabled:         newRule.IsEnabled(),
+		OldNoLoop:          oldRule.NoLoop,
+		NewNoLoop:          newRule.NoLoop,
+		OldLockOnActive:    oldRule.LockOnActive,
+		NewLockOnActive:    newRule.LockOnActive,
+		OldAgendaGroup:     oldRule.AgendaGroup,
+		NewAgendaGroup:     newRule.AgendaGroup,
+		OldActivationGroup: oldRule.ActivationGroup,
+		NewActivationGroup: newRule.ActivationGroup,
+		OldDateEffective:   oldRule.DateEffective,
+		NewDateEffective:   newRule.DateEffective,
+		OldDateExpires:     oldRule.DateExpires,
+		NewDateExpires:     newRule.DateExpires,
+	}
+	diff.SalienceChanged = oldRule.Salience != newRule.Salience
+	diff.DescriptionChanged = oldRule.RuleDescription != newRule.RuleDescription
+	diff.WhenChanged = oldRule.WhenScope.GetSnapshot() != newRule.WhenScope.GetSnapshot()
+	diff.ThenChanges = diffThenExpressions(thenExpressions(oldRule), thenExpressions(newRule))
+	diff.EnabledChanged = diff.OldEnabled != diff.NewEnabled
+	diff.NoLoopChanged = diff.OldNoLoop != diff.NewNoLoop
+	diff.LockOnActiveChanged = diff.OldLockOnActive != diff.NewLockOnActive
+	diff.AgendaGroupChanged = oldRule.GetAgendaGroup() != newRule.GetAgendaGroup()
+	diff.ActivationGroupChanged = diff.OldActivationGroup != diff.NewActivationGroup
+	diff.DateEffectiveChanged = !diff.OldDateEffective.Equal(diff.NewDateEffective)
+	diff.DateExpiresChanged = !diff.OldDateExpires.Equal(diff.NewDateExpires)
+	diff.AnnotationChanges = diffAnnotations(oldRule.Annotations, newRule.Annotations)
+
+	return diff
+}
+
+// diffAnnotations lists the annotations added, removed or whose value changed, sorted by key.
+func diffAnnotations(oldAnnotations, newAnnotations map[string]string) []AnnotationChange {
+	changes := make([]AnnotationChange, 0)
+	for key, oldValue := range oldAnnotations {
+		newValue, ok := newAnnotations[key]
+		switch {
+		case !ok:
+			changes = append(changes, AnnotationChange{Key: key, Removed: true, OldValue: oldValue})
+		case newValue != oldValue:
+			changes = append(changes, AnnotationChange{Key: key, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	for key, newValue := range newAnnotations {
+		if _, ok := oldAnnotations[key]; !ok {
+			changes = append(changes, AnnotationChange{Key: key, Added: true, NewValue: newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+
+		return changes[i].Key < changes[j].Key
+	})
+
+	return changes
+}
+
+func whenText(rule *RuleEntry) string {
+	if rule.WhenScope == nil || rule.WhenScope.Expression == nil {
+
+		return ""
+	}
+
+	return rule.WhenScope.Expression.GrlText
+}
+
+func thenExpressions(rule *RuleEntry) []*ThenExpression {
+	if rule.ThenScope == nil || rule.ThenScope.ThenExpressionList == nil {
+
+		return nil
+	}
+
+	return rule.ThenScope.ThenExpressionList.ThenExpressions
+}
+
+// diffThenExpressions lists the statements removed and added between two then scopes,
+// keeping the longest common subsequence of statements untouched.
+func diffThenExpressions(oldExprs, newExprs []*ThenExpression) []ThenChange {
+	oldSnapshots := make([]string, len(oldExprs))
+	for i, expr := range oldExprs {
+		oldSnapshots[i] = expr.GetSnapshot()
+	}
+	newSnapshots := make([]string, len(newExprs))
+	for i, expr := range newExprs {
+		newSnapshots[i] = expr.GetSnapshot()
+	}
+
+	// common[i][j] is the length of the longest common subsequence of oldSnapshots[i:] and newSnapshots[j:].
+	common := make([][]int, len(oldSnapshots)+1)
+	for i := range common {
+		common[i] = make([]int, len(newSnapshots)+1)
+	}
+	for i := len(oldSnapshots) - 1; i >= 0; i-- {
+		for j := len(newSnapshots) - 1; j >= 0; j-- {
+			switch {
+			case oldSnapshots[i] == newSnapshots[j]:
+				common[i][j] = common[i+1][j+1] + 1
+			case common[i+1][j] >= common[i][j+1]:
+				common[i][j] = common[i+1][j]
+			default:
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	changes := make([]ThenChange, 0)
+	i, j := 0, 0
+	for i < len(oldSnapshots) || j < len(newSnapshots) {
+		switch {
+		case i < len(oldSnapshots) && j < len(newSnapshots) && oldSnapshots[i] == newSnapshots[j]:
+			i++
+			j++
+		case j < len(newSnapshots) && (i == len(oldSnapshots) || common[i][j+1] > common[i+1][j]):
+			changes = append(changes, ThenChange{Kind: ThenStatementAdded, Statement: newExprs[j].GrlText})
+			j++
+		default:
+			changes = append(changes, ThenChange{Kind: ThenStatementRemoved, Statement: oldExprs[i].GrlText})
+			i++
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,81 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+)
+
+// NewKnowledgeBasePool creates a pool of sessions of the compiled knowledge base, keeping at most size idle sessions.
+func (lib *KnowledgeLibrary) NewKnowledgeBasePool(name, version string, size int) (*KnowledgeBasePool, error) {
+	if size < 1 {
+
+		return nil, fmt.Errorf("the pool size must be at least 1, got %d", size)
+	}
+	compiled, err := lib.CompileKnowledgeBase(name, version)
+	if err != nil {
+
+		return nil, err
+	}
+	pool := &KnowledgeBasePool{
+		origin: compiled,
+		idle:   make(chan *KnowledgeBase, size),
+	}
+	pool.idle <- compiled.NewSession()
+
+	return pool, nil
+}
+
+// KnowledgeBasePool recycles the sessions of a compiled knowledge base, so each execution doesn't have to create
+// a new session. It is safe for concurrent use.
+type KnowledgeBasePool struct {
+	origin *KnowledgeBase
+	idle   chan *KnowledgeBase
+}
+
+// Get returns an idle session, or a new one if there's none. The session must not be shared by concurrent executions,
+// give it back with Put once the execution is over.
+func (pool *KnowledgeBasePool) Get() (*KnowledgeBase, error) {
+	select {
+	case instance := <-pool.idle:
+
+		return instance, nil
+	default:
+	}
+
+	return pool.origin.NewSession(), nil
+}
+
+// Put resets the session and makes it idle, so Get can return it. The session is dropped if the pool is full,
+// or if it is not a session of the pool's knowledge base.
+func (pool *KnowledgeBasePool) Put(instance *KnowledgeBase) {
+	if instance == nil || instance == pool.origin || instance.WorkingMemory == nil || !instance.WorkingMemory.SharesIndex(pool.origin.WorkingMemory) {
+
+		return
+	}
+	instance.WorkingMemory.ResetAll()
+	instance.Reset()
+	instance.DataContext = nil
+	select {
+	case pool.idle <- instance:
+	default:
+	}
+}
+
+// Idle returns the number of idle sessions.
+func (pool *KnowledgeBasePool) Idle() int {
+
+	return len(pool.idle)
+}
@@ -0,0 +1,298 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast/unique"
+
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+// NewQueryEntry create new instance of QueryEntry
+func NewQueryEntry() *QueryEntry {
+
+	return &QueryEntry{
+		AstID:            unique.NewID(),
+		QueryName:        "No Name",
+		QueryDescription: "No Description",
+	}
+}
+
+// QueryEntry AST graph node. A query is a boolean expression over the facts, proven goal-first by chaining
+// through the rule entries whose then scopes assign the variables it reads.
+type QueryEntry struct {
+	AstID   string
+	GrlText string
+
+	QueryName        string
+	QueryDescription string
+	// Parameters are the names of the facts the query must be given when it's asked.
+	Parameters []string
+	Expression *Expression
+
+	Declaration Declaration
+}
+
+// QueryEntryReceiver should be implemented by any AST object that receive a QueryEntry
+type QueryEntryReceiver interface {
+	ReceiveQueryEntry(entry *QueryEntry) error
+}
+
+// MakeCatalog create a catalog entry for this AST Node
+func (e *QueryEntry) MakeCatalog(cat *Catalog) {
+	meta := &QueryEntryMeta{
+		NodeMeta: NodeMeta{
+			AstID:    e.AstID,
+			GrlText:  e.GrlText,
+			Snapshot: e.GetSnapshot(),
+		},
+		QueryName:        e.QueryName,
+		QueryDescription: e.QueryDescription,
+		Parameters:       append([]string(nil), e.Parameters...),
+		Declaration:      e.Declaration,
+	}
+	if cat.AddMeta(e.AstID, meta) {
+		if e.Expression != nil {
+			meta.ExpressionID = e.Expression.AstID
+			e.Expression.MakeCatalog(cat)
+		}
+	}
+}
+
+// Clone will clone this QueryEntry. The new clone will have an identical structure
+func (e *QueryEntry) Clone(cloneTable *pkg.CloneTable) *QueryEntry {
+	clone := &QueryEntry{
+		AstID:            unique.NewID(),
+		GrlText:          e.GrlText,
+		QueryName:        e.QueryName,
+		QueryDescription: e.QueryDescription,
+		Parameters:       append([]string(nil), e.Parameters...),
+		Declaration:      e.Declaration,
+	}
+
+	if e.Expression != nil {
+		if cloneTable.IsCloned(e.Expression.AstID) {
+			clone.Expression = cloneTable.Records[e.Expression.AstID].CloneInstance.(*Expression)
+		} else {
+			cloned := e.Expression.Clone(cloneTable)
+			clone.Expression = cloned
+			cloneTable.MarkCloned(e.Expression.AstID, cloned.AstID, e.Expression, cloned)
+		}
+	}
+
+	return clone
+}
+
+// AcceptExpression will accept Expression AST graph node into this node
+func (e *QueryEntry) AcceptExpression(exp *Expression) error {
+	if e.Expression == nil {
+		e.Expression = exp
+
+		return nil
+	}
+
+	return errors.New("expression for query already assigned")
+}
+
+// GetAstID get the UUID asigned for this AST graph node
+func (e *QueryEntry) GetAstID() string {
+
+	return e.AstID
+}
+
+// GetGrlText get the expression syntax related to this graph when it wast constructed
+func (e *QueryEntry) GetGrlText() string {
+
+	return e.GrlText
+}
+
+// GetSnapshot will create a structure signature or AST graph
+func (e *QueryEntry) GetSnapshot() string {
+	var buff strings.Builder
+	buff.WriteString(QUERYENTRY)
+	buff.WriteString("(")
+	buff.WriteString(fmt.Sprintf("N:%s DEC:\"%s\" P:%s E:", e.QueryName, e.QueryDescription, strings.Join(e.Parameters, ",")))
+	if e.Expression != nil {
+		buff.WriteString(e.Expression.GetSnapshot())
+	}
+	buff.WriteString(")")
+
+	return buff.String()
+}
+
+// SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
+// call this function.
+func (e *QueryEntry) SetGrlText(grlText string) {
+	e.GrlText = grlText
+}
+
+// Evaluate will evaluate the expression of this query.
+func (e *QueryEntry) Evaluate(ctx context.Context, dataContext IDataContext, memory *WorkingMemory) (answer bool, err error) {
+	if ctx.Err() != nil {
+
+		return false, fmt.Errorf("context error on evaluating query %s. got %w", e.QueryName, ctx.Err())
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("error while evaluating query %s ! recovered : %v", e.QueryName, r)
+			answer = false
+		}
+	}()
+	val, err := e.Expression.Evaluate(dataContext, memory)
+	if err != nil {
+
+		return false, fmt.Errorf("evaluating expression in query '%s' raised an error. got %v", e.QueryName, err)
+	}
+	if val.Kind() != reflect.Bool {
+
+		return false, fmt.Errorf("evaluating expression in query '%s', it is not a boolean expression : %s", e.QueryName, e.Expression.GetGrlText())
+	}
+
+	return val.Bool(), nil
+}
+
+// Prove evaluates this query goal-first. If it doesn't hold, the rule entries assigning a variable it reads are
+// tried in the specified order. The when scope of such rule entry is proven the same way, then its then scope is
+// executed and the query evaluated again. Each rule entry is executed at most once. Prove returns the answer and
+// the rule entries that proved it, in the order they were executed: a rule entry executed for a when scope that
+// couldn't be proven is left out, unless a goal proven afterward reads a variable it assigns. There's no rule entry
+// if the answer is false.
+func (e *QueryEntry) Prove(ctx context.Context, dataContext IDataContext, memory *WorkingMemory, ruleEntries []*RuleEntry) (bool, []*RuleEntry, error) {
+	if e.Expression == nil {
+
+		return false, nil, fmt.Errorf("query %s have no expression", e.QueryName)
+	}
+	p := &prover{
+		ctx:         ctx,
+		dataContext: dataContext,
+		memory:      memory,
+		ruleEntries: ruleEntries,
+		assigned:    make(map[*RuleEntry]map[string]bool, len(ruleEntries)),
+		proving:     make(map[*RuleEntry]bool),
+		executed:    make(map[*RuleEntry]int),
+	}
+	for _, ruleEntry := range ruleEntries {
+		names := make(map[string]bool)
+		if ruleEntry.ThenScope != nil {
+			ruleEntry.ThenScope.collectAssignedVariableNames(names)
+		}
+		p.assigned[ruleEntry] = names
+	}
+	answer, proof, err := p.prove(variableNames(e.Expression), func() (bool, error) {
+
+		return e.Evaluate(ctx, dataContext, memory)
+	})
+	if err != nil || !answer {
+
+		return answer, nil, err
+	}
+
+	return answer, p.ordered(proof), nil
+}
+
+// prover holds the state of a query being proven.
+type prover struct {
+	ctx         context.Context
+	dataContext IDataContext
+	memory      *WorkingMemory
+	ruleEntries []*RuleEntry
+	// assigned are the variables each rule entry's then scope assigns.
+	assigned map[*RuleEntry]map[string]bool
+	// proving are the rule entries whose when scope is being proven, they can't be used to prove it.
+	proving map[*RuleEntry]bool
+	// executed are the rule entries executed, by the order of their execution.
+	executed map[*RuleEntry]int
+}
+
+// prove tells whether the goal holds, executing the rule entries assigning its variables until it does. It returns
+// the rule entries proving the goal: those executed for it, and those already executed assigning its variables.
+func (p *prover) prove(variables map[string]bool, goal func() (bool, error)) (bool, []*RuleEntry, error) {
+	proof := make([]*RuleEntry, 0)
+	for ruleEntry := range p.executed {
+		if assignsAny(p.assigned[ruleEntry], variables) {
+			proof = append(proof, ruleEntry)
+		}
+	}
+	holds, err := goal()
+	if err != nil || holds {
+
+		return holds, proof, err
+	}
+	for _, ruleEntry := range p.ruleEntries {
+		if _, executed := p.executed[ruleEntry]; executed || p.proving[ruleEntry] || !assignsAny(p.assigned[ruleEntry], variables) {
+			continue
+		}
+		var whenVariables map[string]bool
+		if ruleEntry.WhenScope != nil && ruleEntry.WhenScope.Expression != nil {
+			whenVariables = variableNames(ruleEntry.WhenScope.Expression)
+		}
+		p.proving[ruleEntry] = true
+		can, whenProof, err := p.prove(whenVariables, func() (bool, error) {
+
+			return ruleEntry.Evaluate(p.ctx, p.dataContext, p.memory)
+		})
+		delete(p.proving, ruleEntry)
+		if err != nil {
+
+			return false, nil, err
+		}
+		if !can {
+			continue
+		}
+		AstLog.Debugf("Executing rule %s to prove %v", ruleEntry.RuleName, variables)
+		err = ruleEntry.Execute(p.ctx, p.dataContext, p.memory)
+		if err != nil {
+
+			return false, nil, err
+		}
+		p.executed[ruleEntry] = len(p.executed)
+		proof = append(proof, whenProof...)
+		proof = append(proof, ruleEntry)
+		// the then scope may change the facts through function calls, which the working memory doesn't track.
+		p.memory.ResetAll()
+		holds, err = goal()
+		if err != nil || holds {
+
+			return holds, proof, err
+		}
+	}
+
+	return false, nil, nil
+}
+
+// ordered returns the distinct rule entries of the proof, in the order they were executed.
+func (p *prover) ordered(proof []*RuleEntry) []*RuleEntry {
+	ordered := make([]*RuleEntry, 0, len(proof))
+	seen := make(map[*RuleEntry]bool, len(proof))
+	for _, ruleEntry := range proof {
+		if !seen[ruleEntry] {
+			seen[ruleEntry] = true
+			ordered = append(ordered, ruleEntry)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+
+		return p.executed[ordered[i]] < p.executed[ordered[j]]
+	})
+
+	return ordered
+}
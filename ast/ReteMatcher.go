@@ -0,0 +1,131 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import "math/bits"
+
+// matchState tells whether the when scope of a rule entry holds, as far as a ReteMatcher knows.
+type matchState int8
+
+const (
+	matchUnknown matchState = iota
+	matchHolds
+	matchFails
+)
+
+// NewMatcher creates a matcher of the rule entries of this network, for an execution using the working memory.
+// The working memory propagates the nodes it forgets to the matcher, until the matcher is detached.
+// The match of every rule entry is unknown at first.
+func (n *ReteNetwork) NewMatcher(memory *WorkingMemory) *ReteMatcher {
+	matcher := &ReteMatcher{
+		network:    n,
+		memory:     memory,
+		states:     make([]matchState, len(n.ruleEntries)),
+		candidates: make([]uint64, (len(n.ruleEntries)+63)/64),
+	}
+	matcher.reset()
+	memory.matcher = matcher
+
+	return matcher
+}
+
+// ReteMatcher maintains whether the when scope of each rule entry of a network holds, during an execution.
+// A rule entry is only evaluated again once a change propagates to it through the network.
+type ReteMatcher struct {
+	network *ReteNetwork
+	memory  *WorkingMemory
+	// states are the matches of the rule entries, by their order in the network.
+	states []matchState
+	// candidates is the set of the orders of the rule entries whose match holds or is unknown, one bit per order.
+	candidates []uint64
+}
+
+// AppendCandidates appends the orders of the rule entries whose match holds or is unknown to the slice, in order,
+// and returns it, so the caller can reuse the slice from a cycle to the next. The other rule entries are known to
+// fail, they don't need to be visited until a change propagates to them.
+func (m *ReteMatcher) AppendCandidates(orders []int) []int {
+	for word, set := range m.candidates {
+		for set != 0 {
+			bit := bits.TrailingZeros64(set)
+			orders = append(orders, word*64+bit)
+			set &^= 1 << uint(bit)
+		}
+	}
+
+	return orders
+}
+
+// setState records the match of the rule entry of that order, and whether it's a candidate.
+func (m *ReteMatcher) setState(order int, state matchState) {
+	m.states[order] = state
+	if state == matchFails {
+		m.candidates[order/64] &^= 1 << uint(order%64)
+	} else {
+		m.candidates[order/64] |= 1 << uint(order%64)
+	}
+}
+
+// Detach stops the propagation of the nodes the working memory forgets to this matcher.
+func (m *ReteMatcher) Detach() {
+	if m.memory.matcher == m {
+		m.memory.matcher = nil
+	}
+}
+
+// Match tells whether the when scope of the rule entry of that order holds, if it's known.
+// When it's not known, the rule entry must be evaluated.
+func (m *ReteMatcher) Match(order int) (holds bool, known bool) {
+	state := m.states[order]
+
+	return state == matchHolds, state != matchUnknown
+}
+
+// Evaluated records the evaluation of the when scope of the rule entry of that order.
+// The match of a rule entry failing to evaluate stays unknown, so it's evaluated again.
+func (m *ReteMatcher) Evaluated(order int, holds bool, err error) {
+	switch {
+	case err != nil:
+		m.setState(order, matchUnknown)
+	case holds:
+		m.setState(order, matchHolds)
+	default:
+		m.setState(order, matchFails)
+	}
+}
+
+// forgotten propagates the AST node forgotten by the working memory to the nodes including it,
+// which are forgotten too, and to the rule entries whose match becomes unknown.
+func (m *ReteMatcher) forgotten(astNode interface{}) {
+	node, ok := m.network.nodes[astNode]
+	if !ok {
+
+		return
+	}
+	for _, ancestor := range node.ancestors {
+		if state := m.memory.cachedState(ancestor); state != nil {
+			state.evaluated = false
+		}
+	}
+	for _, order := range node.ruleEntries {
+		m.setState(order, matchUnknown)
+	}
+}
+
+// reset makes the match of every rule entry unknown.
+func (m *ReteMatcher) reset() {
+	for order := range m.states {
+		m.setState(order, matchUnknown)
+	}
+}
@@ -0,0 +1,187 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+// NewReteNetwork builds the RETE network of the when scopes of the rule entries, in the order they are given.
+func NewReteNetwork(ruleEntries []*RuleEntry) *ReteNetwork {
+	network := &ReteNetwork{
+		ruleEntries: ruleEntries,
+		factNames:   make([][]string, len(ruleEntries)),
+		nodes:       make(map[interface{}]*reteNode),
+	}
+	for order, ruleEntry := range ruleEntries {
+		network.factNames[order] = ruleEntry.FactNames()
+		if ruleEntry.WhenScope != nil && ruleEntry.WhenScope.Expression != nil {
+			node := network.addExpression(ruleEntry.WhenScope.Expression, nil)
+			node.terminals = append(node.terminals, order)
+		}
+	}
+	for _, node := range network.nodes {
+		node.close()
+	}
+
+	return network
+}
+
+// ReteNetwork links the expressions and expression atoms of the when scopes of a knowledge base to the expressions
+// including them, up to the when scopes of the rule entries. It's not a full RETE network: it holds no facts, there
+// are no alpha memories nor joins, the values stay cached in the working memory. It only tells which when scopes a
+// change reaches. The AST nodes are shared by the rule entries, as the working memory never duplicates them.
+// When the working memory forgets a node because a variable changed, the change propagates to the expressions
+// including it, which are forgotten too, and to the rule entries whose when scope must be evaluated again. The rule
+// entries the change doesn't reach keep their match.
+type ReteNetwork struct {
+	ruleEntries []*RuleEntry
+	// factNames are the names of the facts each rule entry refers to, by order.
+	factNames [][]string
+	nodes     map[interface{}]*reteNode
+}
+
+// reteNode is an expression or an expression atom of the network.
+type reteNode struct {
+	astNode interface{}
+	// parents are the nodes including this node.
+	parents []*reteNode
+	// terminals are the orders of the rule entries whose when scope is this node.
+	terminals []int
+
+	// closed is true once ancestors and ruleEntries are computed.
+	closed bool
+	// ancestors are the AST nodes including this node, directly or not.
+	ancestors []interface{}
+	// ruleEntries are the orders of the rule entries whose when scope includes this node, directly or not.
+	ruleEntries []int
+}
+
+// RuleEntries returns the rule entries of this network, in their order.
+func (n *ReteNetwork) RuleEntries() []*RuleEntry {
+
+	return n.ruleEntries
+}
+
+// FactNames returns the names of the facts the rule entry of that order refers to, see RuleEntry.FactNames.
+func (n *ReteNetwork) FactNames(order int) []string {
+
+	return n.factNames[order]
+}
+
+// Nodes returns the number of expressions and expression atoms of this network.
+func (n *ReteNetwork) Nodes() int {
+
+	return len(n.nodes)
+}
+
+// join returns the network node of the AST node, joined to its parent. It tells whether the node was already
+// in the network, with the nodes it includes.
+func (n *ReteNetwork) join(astNode interface{}, parent *reteNode) (*reteNode, bool) {
+	node, ok := n.nodes[astNode]
+	if !ok {
+		node = &reteNode{astNode: astNode}
+		n.nodes[astNode] = node
+	}
+	if parent != nil {
+		for _, existing := range node.parents {
+			if existing == parent {
+
+				return node, ok
+			}
+		}
+		node.parents = append(node.parents, parent)
+	}
+
+	return node, ok
+}
+
+func (n *ReteNetwork) addExpression(expression *Expression, parent *reteNode) *reteNode {
+	node, ok := n.join(expression, parent)
+	if ok {
+
+		return node
+	}
+	for _, child := range []*Expression{expression.LeftExpression, expression.RightExpression, expression.SingleExpression} {
+		if child != nil {
+			n.addExpression(child, node)
+		}
+	}
+	if expression.ExpressionAtom != nil {
+		n.addExpressionAtom(expression.ExpressionAtom, node)
+	}
+
+	return node
+}
+
+func (n *ReteNetwork) addExpressionAtom(atom *ExpressionAtom, parent *reteNode) {
+	node, ok := n.join(atom, parent)
+	if ok {
+
+		return
+	}
+	if atom.ExpressionAtom != nil {
+		n.addExpressionAtom(atom.ExpressionAtom, node)
+	}
+	if atom.Variable != nil {
+		n.addVariable(atom.Variable, node)
+	}
+	if atom.FunctionCall != nil && atom.FunctionCall.ArgumentList != nil {
+		for _, argument := range atom.FunctionCall.ArgumentList.Arguments {
+			n.addExpression(argument, node)
+		}
+	}
+	if atom.ArrayMapSelector != nil && atom.ArrayMapSelector.Expression != nil {
+		n.addExpression(atom.ArrayMapSelector.Expression, node)
+	}
+}
+
+// addVariable adds the expressions selecting the elements of the variable, they join the expression atom reading it.
+func (n *ReteNetwork) addVariable(variable *Variable, atomNode *reteNode) {
+	if variable.Variable != nil {
+		n.addVariable(variable.Variable, atomNode)
+	}
+	if variable.ArrayMapSelector != nil && variable.ArrayMapSelector.Expression != nil {
+		n.addExpression(variable.ArrayMapSelector.Expression, atomNode)
+	}
+}
+
+// close computes the AST nodes and the rule entries a change of this node propagates to.
+func (node *reteNode) close() {
+	if node.closed {
+
+		return
+	}
+	node.closed = true
+	ancestors := make(map[interface{}]bool)
+	ruleEntries := make(map[int]bool)
+	for _, order := range node.terminals {
+		ruleEntries[order] = true
+	}
+	for _, parent := range node.parents {
+		parent.close()
+		ancestors[parent.astNode] = true
+		for _, ancestor := range parent.ancestors {
+			ancestors[ancestor] = true
+		}
+		for _, order := range parent.ruleEntries {
+			ruleEntries[order] = true
+		}
+	}
+	node.ancestors = make([]interface{}, 0, len(ancestors))
+	for ancestor := range ancestors {
+		node.ancestors = append(node.ancestors, ancestor)
+	}
+	node.ruleEntries = make([]int, 0, len(ruleEntries))
+	for order := range ruleEntries {
+		node.ruleEntries = append(node.ruleEntries, order)
+	}
+}
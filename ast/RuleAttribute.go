@@ -0,0 +1,65 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import "fmt"
+
+// NewRuleAttribute create new RuleAttribute AST object
+func NewRuleAttribute() *RuleAttribute {
+
+	return &RuleAttribute{}
+}
+
+// RuleAttribute is a simple AST object that stores a rule attribute, such as `group "pricing"`.
+// Attributes are written between the rule's salience and its body.
+type RuleAttribute struct {
+	Name string
+
+	// HasValue is false for flag attributes that are written without a value.
+	HasValue     bool
+	StringValue  *string
+	BooleanValue *bool
+}
+
+// RuleAttributeReceiver must be implemented by any AST object that stores rule attributes
+type RuleAttributeReceiver interface {
+	AcceptRuleAttribute(attribute *RuleAttribute) error
+}
+
+// AcceptStringLiteral accept the assigned string value
+func (attr *RuleAttribute) AcceptStringLiteral(lit *StringLiteral) {
+	attr.HasValue = true
+	attr.StringValue = &lit.String
+}
+
+// AcceptBooleanLiteral accept the assigned boolean value
+func (attr *RuleAttribute) AcceptBooleanLiteral(lit *BooleanLiteral) {
+	attr.HasValue = true
+	attr.BooleanValue = &lit.Boolean
+}
+
+// Flag returns the value of a flag attribute. A flag written without a value is true.
+func (attr *RuleAttribute) Flag() (bool, error) {
+	if !attr.HasValue {
+
+		return true, nil
+	}
+	if attr.BooleanValue == nil {
+
+		return false, fmt.Errorf("attribute %s requires a boolean value", attr.Name)
+	}
+
+	return *attr.BooleanValue, nil
+}
@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hyperjumptech/grule-rule-engine/ast/unique"
 
@@ -47,8 +49,71 @@ type RuleEntry struct {
 	WhenScope       *WhenScope
 	ThenScope       *ThenScope
 
-	Retracted bool
-	Deleted   bool //If this is true, it will be ignored while execution and fetching the matching rules
+	Deleted bool //If this is true, it will be ignored while execution and fetching the matching rules
+
+	Declaration Declaration
+
+	// AgendaGroup is the agenda group this rule belongs to, an empty group means MainAgendaGroup.
+	AgendaGroup string
+	// NoLoop prevents this rule from being activated again by its own changes.
+	NoLoop bool
+	// LockOnActive prevents this rule from being activated again while its agenda group keeps the focus.
+	LockOnActive bool
+	// ActivationGroup makes this rule mutually exclusive with the other rules in the same activation group,
+	// once one of them is executed the others are cancelled for the rest of the execution.
+	ActivationGroup string
+
+	// Annotations are the key-value metadata written before the rule, eg. `@owner("risk-team")`.
+	Annotations map[string]string
+
+	// Disabled rule is never evaluated, it is set using the `enabled false` attribute.
+	Disabled bool
+	// DateEffective is the time this rule starts to be active, a zero time means it's active since forever.
+	DateEffective time.Time
+	// DateExpires is the time this rule stops to be active, a zero time means it never expires.
+	DateExpires time.Time
+}
+
+// ruleDateLayouts are the layouts accepted by the date-effective and date-expires attributes.
+// Dates without a time zone are in the local time zone.
+var ruleDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"}
+
+// parseRuleDate will parse the value of the date-effective and date-expires attributes.
+func parseRuleDate(value string) (time.Time, error) {
+	for _, layout := range ruleDateLayouts {
+		date, err := time.ParseInLocation(layout, value, time.Local)
+		if err == nil {
+
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %s, the date must be formatted as YYYY-MM-DD, YYYY-MM-DD hh:mm:ss or RFC3339", value)
+}
+
+// Declaration records where a rule entry was declared, it is used to keep the rule entries
+// in the order they were written.
+type Declaration struct {
+	// Resource is the description of the resource the rule entry was loaded from, eg. the file name.
+	Resource string
+	// ResourceIndex is the order of the resource within the knowledge base, the first resource loaded is 0.
+	ResourceIndex int
+	// Position is the order of the rule entry within its resource, the first rule entry is 0.
+	Position int
+	// Line is the line number where the rule entry starts within its resource.
+	Line int
+	// Column is the column number where the rule entry starts within its resource.
+	Column int
+}
+
+// Before will check if this declaration comes before the other declaration.
+func (d Declaration) Before(that Declaration) bool {
+	if d.ResourceIndex != that.ResourceIndex {
+
+		return d.ResourceIndex < that.ResourceIndex
+	}
+
+	return d.Position < that.Position
 }
 
 // MakeCatalog will create a catalog entry from RuleEntry node.
@@ -72,6 +137,15 @@ func (e *RuleEntry) MakeCatalog(cat *Catalog) {
 		meta.RuleName = e.RuleName
 		meta.RuleDescription = e.RuleDescription
 		meta.Salience = e.Salience
+		meta.Declaration = e.Declaration
+		meta.AgendaGroup = e.AgendaGroup
+		meta.NoLoop = e.NoLoop
+		meta.LockOnActive = e.LockOnActive
+		meta.ActivationGroup = e.ActivationGroup
+		meta.Annotations = copyAnnotations(e.Annotations)
+		meta.Disabled = e.Disabled
+		meta.DateEffective = e.DateEffective
+		meta.DateExpires = e.DateExpires
 	}
 }
 
@@ -87,6 +161,142 @@ func (e *RuleEntry) AcceptSalience(salience *Salience) error {
 	return nil
 }
 
+// AcceptRuleAttribute will accept a rule attribute and apply it to this rule entry
+func (e *RuleEntry) AcceptRuleAttribute(attribute *RuleAttribute) error {
+	switch attribute.Name {
+	case "group":
+		if attribute.StringValue == nil {
+
+			return fmt.Errorf("rule %s attribute %s requires a string value", e.RuleName, attribute.Name)
+		}
+		e.AgendaGroup = *attribute.StringValue
+	case "activation-group":
+		if attribute.StringValue == nil {
+
+			return fmt.Errorf("rule %s attribute %s requires a string value", e.RuleName, attribute.Name)
+		}
+		e.ActivationGroup = *attribute.StringValue
+	case "no-loop":
+		flag, err := attribute.Flag()
+		if err != nil {
+
+			return fmt.Errorf("rule %s %w", e.RuleName, err)
+		}
+		e.NoLoop = flag
+	case "lock-on-active":
+		flag, err := attribute.Flag()
+		if err != nil {
+
+			return fmt.Errorf("rule %s %w", e.RuleName, err)
+		}
+		e.LockOnActive = flag
+	case "enabled":
+		flag, err := attribute.Flag()
+		if err != nil {
+
+			return fmt.Errorf("rule %s %w", e.RuleName, err)
+		}
+		e.Disabled = !flag
+	case "date-effective", "date-expires":
+		if attribute.StringValue == nil {
+
+			return fmt.Errorf("rule %s attribute %s requires a string value", e.RuleName, attribute.Name)
+		}
+		date, err := parseRuleDate(*attribute.StringValue)
+		if err != nil {
+
+			return fmt.Errorf("rule %s attribute %s has %w", e.RuleName, attribute.Name, err)
+		}
+		if attribute.Name == "date-effective" {
+			e.DateEffective = date
+		} else {
+			e.DateExpires = date
+		}
+	default:
+
+		return fmt.Errorf("rule %s has unknown attribute %s", e.RuleName, attribute.Name)
+	}
+
+	return nil
+}
+
+// AcceptAnnotation will accept an annotation of this rule entry
+func (e *RuleEntry) AcceptAnnotation(annotation *Annotation) error {
+	if _, exist := e.Annotations[annotation.Key]; exist {
+
+		return fmt.Errorf("rule %s has duplicate annotation @%s", e.RuleName, annotation.Key)
+	}
+	if e.Annotations == nil {
+		e.Annotations = make(map[string]string)
+	}
+	e.Annotations[annotation.Key] = annotation.Value
+
+	return nil
+}
+
+// GetAnnotation returns the value of the annotation with the specified key, and whether this rule entry has it.
+func (e *RuleEntry) GetAnnotation(key string) (string, bool) {
+	value, ok := e.Annotations[key]
+
+	return value, ok
+}
+
+// HasAnnotation will check if this rule entry has the annotation with the specified key and value.
+func (e *RuleEntry) HasAnnotation(key, value string) bool {
+	annotated, ok := e.Annotations[key]
+
+	return ok && annotated == value
+}
+
+// copyAnnotations returns a copy of the annotations map, or nil if there's no annotation.
+func copyAnnotations(annotations map[string]string) map[string]string {
+	if len(annotations) == 0 {
+
+		return nil
+	}
+	annotationCopy := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		annotationCopy[key] = value
+	}
+
+	return annotationCopy
+}
+
+// IsEnabled will check if this rule entry is enabled.
+func (e *RuleEntry) IsEnabled() bool {
+
+	return !e.Disabled
+}
+
+// IsActive will check if this rule entry is enabled, and the specified time is within its date-effective and
+// date-expires window. Rule entry that is not active is not evaluated by the engine.
+func (e *RuleEntry) IsActive(now time.Time) bool {
+	if e.Disabled {
+
+		return false
+	}
+	if !e.DateEffective.IsZero() && now.Before(e.DateEffective) {
+
+		return false
+	}
+	if !e.DateExpires.IsZero() && !now.Before(e.DateExpires) {
+
+		return false
+	}
+
+	return true
+}
+
+// GetAgendaGroup returns the agenda group of this rule entry, rules without a group belong to MainAgendaGroup.
+func (e *RuleEntry) GetAgendaGroup() string {
+	if len(e.AgendaGroup) == 0 {
+
+		return MainAgendaGroup
+	}
+
+	return e.AgendaGroup
+}
+
 // AcceptWhenScope will accept WhenScope AST Graph into this AST Graph
 func (e *RuleEntry) AcceptWhenScope(when *WhenScope) error {
 	e.WhenScope = when
@@ -109,8 +319,16 @@ func (e *RuleEntry) Clone(cloneTable *pkg.CloneTable) *RuleEntry {
 		RuleName:        e.RuleName,
 		RuleDescription: e.RuleDescription,
 		Salience:        e.Salience,
-		Retracted:       false,
 		Deleted:         e.Deleted,
+		Declaration:     e.Declaration,
+		AgendaGroup:     e.AgendaGroup,
+		NoLoop:          e.NoLoop,
+		LockOnActive:    e.LockOnActive,
+		ActivationGroup: e.ActivationGroup,
+		Annotations:     copyAnnotations(e.Annotations),
+		Disabled:        e.Disabled,
+		DateEffective:   e.DateEffective,
+		DateExpires:     e.DateExpires,
 	}
 	if e.WhenScope != nil {
 		if cloneTable.IsCloned(e.WhenScope.AstID) {
@@ -152,18 +370,141 @@ func (e *RuleEntry) GetSnapshot() string {
 	var buff strings.Builder
 	buff.WriteString(RULEENTRY)
 	buff.WriteString("(")
-	buff.WriteString(fmt.Sprintf("N:%s DEC:\"%s\" SAL:%d W:%s T:%s}", e.RuleName, e.RuleDescription, e.Salience, e.WhenScope.GetSnapshot(), e.ThenScope.GetSnapshot()))
+	buff.WriteString(fmt.Sprintf("N:%s DEC:\"%s\" SAL:%d%s W:%s T:%s}", e.RuleName, e.RuleDescription, e.Salience, e.attributesSnapshot(), e.WhenScope.GetSnapshot(), e.ThenScope.GetSnapshot()))
 	buff.WriteString(")")
 
 	return buff.String()
 }
 
+// attributesSnapshot returns the signature of the attributes and the annotations that differ from their default.
+func (e *RuleEntry) attributesSnapshot() string {
+	var buff strings.Builder
+	if e.Disabled {
+		buff.WriteString(" DIS")
+	}
+	if e.NoLoop {
+		buff.WriteString(" NL")
+	}
+	if e.LockOnActive {
+		buff.WriteString(" LOA")
+	}
+	if len(e.AgendaGroup) > 0 {
+		buff.WriteString(fmt.Sprintf(" AG:%q", e.AgendaGroup))
+	}
+	if len(e.ActivationGroup) > 0 {
+		buff.WriteString(fmt.Sprintf(" ACG:%q", e.ActivationGroup))
+	}
+	if !e.DateEffective.IsZero() {
+		buff.WriteString(fmt.Sprintf(" DEF:%s", formatMetaTime(e.DateEffective)))
+	}
+	if !e.DateExpires.IsZero() {
+		buff.WriteString(fmt.Sprintf(" DEX:%s", formatMetaTime(e.DateExpires)))
+	}
+	if len(e.Annotations) > 0 {
+		keys := make([]string, 0, len(e.Annotations))
+		for key := range e.Annotations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		buff.WriteString(" ANN:")
+		for i, key := range keys {
+			if i > 0 {
+				buff.WriteString(",")
+			}
+			buff.WriteString(fmt.Sprintf("%s=%q", key, e.Annotations[key]))
+		}
+	}
+
+	return buff.String()
+}
+
 // SetGrlText set the expression syntax related to this graph when it was constructed. Only ANTLR4 listener should
 // call this function.
 func (e *RuleEntry) SetGrlText(grlText string) {
 	e.GrlText = grlText
 }
 
+// Complexity returns the complexity of this rule's when scope expression.
+func (e *RuleEntry) Complexity() int {
+	if e.WhenScope == nil || e.WhenScope.Expression == nil {
+
+		return 0
+	}
+
+	return e.WhenScope.Expression.Complexity()
+}
+
+// FactNames returns the sorted names of the facts this rule entry refers to, in its when and then scopes.
+func (e *RuleEntry) FactNames() []string {
+	names := make(map[string]bool)
+	if e.WhenScope != nil {
+		e.WhenScope.collectFactNames(names)
+	}
+	if e.ThenScope != nil {
+		e.ThenScope.collectFactNames(names)
+	}
+	factNames := make([]string, 0, len(names))
+	for name := range names {
+		factNames = append(factNames, name)
+	}
+	sort.Strings(factNames)
+
+	return factNames
+}
+
+// MayActivate tells whether executing this rule entry may change a variable the when scope of that rule entry reads,
+// either by assigning it, one of its members or elements, or the variable it belongs to, by calling its methods or
+// passing it to a custom function, or by inserting, modifying, deleting or forgetting its fact with the built-in
+// functions.
+func (e *RuleEntry) MayActivate(that *RuleEntry) bool {
+	if e.ThenScope == nil || e.ThenScope.ThenExpressionList == nil || that.WhenScope == nil || that.WhenScope.Expression == nil {
+
+		return false
+	}
+	changed := make(map[string]bool)
+	e.ThenScope.ThenExpressionList.collectChangedVariableNames(changed)
+
+	return assignsAny(changed, variableNames(that.WhenScope.Expression))
+}
+
+// anyVariable stands for every variable, among the variables changed by a then scope calling a built-in function that
+// changes a fact whose name is not a constant.
+const anyVariable = "*"
+
+// variableNames returns the variables the expression reads.
+func variableNames(expression *Expression) map[string]bool {
+	names := make(map[string]bool)
+	expression.collectVariableNames(names)
+
+	return names
+}
+
+// assignsAny tells whether one of the assigned variables is one of the variables, or is within or contains one.
+// A then scope whose changes can't be told, see anyVariable, changes all of them.
+func assignsAny(assigned, variables map[string]bool) bool {
+	if assigned[anyVariable] && len(variables) > 0 {
+
+		return true
+	}
+	for name := range assigned {
+		for variable := range variables {
+			if name == variable || isWithinVariable(name, variable) || isWithinVariable(variable, name) {
+
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isWithinVariable tells whether the inner variable is a member or an element of the outer variable,
+// eg. Fact.Customer.Age is within Fact.Customer and Fact.Flags["a"] is within Fact.Flags.
+func isWithinVariable(inner, outer string) bool {
+
+	return strings.HasPrefix(inner, outer+".") || strings.HasPrefix(inner, outer+"[")
+}
+
 // Evaluate will evaluate this AST graph for when scope evaluation
 func (e *RuleEntry) Evaluate(ctx context.Context, dataContext IDataContext, memory *WorkingMemory) (can bool, err error) {
 	if ctx.Err() != nil {
@@ -176,10 +517,6 @@ func (e *RuleEntry) Evaluate(ctx context.Context, dataContext IDataContext, memo
 			can = false
 		}
 	}()
-	if e.Retracted {
-
-		return false, nil
-	}
 	val, err := e.WhenScope.Evaluate(dataContext, memory)
 	if err != nil {
 		AstLog.Errorf("Error while evaluating rule %s, got %v", e.RuleName, err)
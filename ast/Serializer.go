@@ -23,6 +23,8 @@ import (
 	"io"
 	"math"
 	"reflect"
+	"sort"
+	"time"
 )
 
 // NodeType is to label a Meta information within catalog
@@ -68,8 +70,12 @@ const (
 	// TypeBoolean variable type boolean label
 	TypeBoolean
 
+	// TypeQueryEntry meta type of QueryEntry, declared after the value types so the values of the existing types
+	// don't change.
+	TypeQueryEntry NodeType = iota
+
 	// Version will be written to the stream and used for compatibility check
-	Version = "1.8"
+	Version = "1.9"
 )
 
 // Catalog used to catalog all AST nodes in a KnowledgeBase.
@@ -246,6 +252,15 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				RuleName:        amet.RuleName,
 				RuleDescription: amet.RuleDescription,
 				Salience:        amet.Salience,
+				Declaration:     amet.Declaration,
+				AgendaGroup:     amet.AgendaGroup,
+				NoLoop:          amet.NoLoop,
+				LockOnActive:    amet.LockOnActive,
+				ActivationGroup: amet.ActivationGroup,
+				Annotations:     copyAnnotations(amet.Annotations),
+				Disabled:        amet.Disabled,
+				DateEffective:   amet.DateEffective,
+				DateExpires:     amet.DateExpires,
 				WhenScope:       nil,
 				ThenScope:       nil,
 			}
@@ -294,6 +309,22 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 				Expression: nil,
 			}
 			importTable[amet.AstID] = n
+		case TypeQueryEntry:
+			amet := meta.(*QueryEntryMeta)
+			queryEntry := &QueryEntry{
+				AstID:            amet.AstID,
+				GrlText:          amet.GrlText,
+				QueryName:        amet.QueryName,
+				QueryDescription: amet.QueryDescription,
+				Parameters:       append([]string(nil), amet.Parameters...),
+				Declaration:      amet.Declaration,
+				Expression:       nil,
+			}
+			importTable[amet.AstID] = queryEntry
+			if knowledgeBase.QueryEntries == nil {
+				knowledgeBase.QueryEntries = make(map[string]*QueryEntry)
+			}
+			knowledgeBase.QueryEntries[queryEntry.QueryName] = queryEntry
 		default:
 			return nil, fmt.Errorf("unrecognized meta type %d", meta.GetASTType())
 		}
@@ -421,6 +452,12 @@ func (cat *Catalog) BuildKnowledgeBase() (*KnowledgeBase, error) {
 			if len(amet.ExpressionID) > 0 {
 				whenScope.Expression = importTable[amet.ExpressionID].(*Expression)
 			}
+		case TypeQueryEntry:
+			queryEntry := node.(*QueryEntry)
+			amet := meta.(*QueryEntryMeta)
+			if len(amet.ExpressionID) > 0 {
+				queryEntry.Expression = importTable[amet.ExpressionID].(*Expression)
+			}
 		default:
 			return nil, fmt.Errorf("unknown AST type")
 		}
@@ -654,6 +691,8 @@ func (cat *Catalog) ReadCatalogFromReader(reader io.Reader) error {
 			meta = &VariableMeta{}
 		case TypeWhenScope:
 			meta = &WhenScopeMeta{}
+		case TypeQueryEntry:
+			meta = &QueryEntryMeta{}
 		default:
 
 			return fmt.Errorf("unknown meta number %d", metaType)
@@ -1974,6 +2013,15 @@ type RuleEntryMeta struct {
 	Salience        int
 	WhenScopeID     string
 	ThenScopeID     string
+	Declaration     Declaration
+	AgendaGroup     string
+	NoLoop          bool
+	LockOnActive    bool
+	ActivationGroup string
+	Annotations     map[string]string
+	Disabled        bool
+	DateEffective   time.Time
+	DateExpires     time.Time
 }
 
 // Equals basic function to test equality of two MetaNode
@@ -2003,6 +2051,48 @@ func (meta *RuleEntryMeta) Equals(that Meta) bool {
 
 			return false
 		}
+		if meta.Declaration != ins.Declaration {
+
+			return false
+		}
+		if meta.AgendaGroup != ins.AgendaGroup {
+
+			return false
+		}
+		if meta.NoLoop != ins.NoLoop {
+
+			return false
+		}
+		if meta.LockOnActive != ins.LockOnActive {
+
+			return false
+		}
+		if meta.ActivationGroup != ins.ActivationGroup {
+
+			return false
+		}
+		if len(meta.Annotations) != len(ins.Annotations) {
+
+			return false
+		}
+		for key, value := range meta.Annotations {
+			if thatValue, ok := ins.Annotations[key]; !ok || thatValue != value {
+
+				return false
+			}
+		}
+		if meta.Disabled != ins.Disabled {
+
+			return false
+		}
+		if !meta.DateEffective.Equal(ins.DateEffective) {
+
+			return false
+		}
+		if !meta.DateExpires.Equal(ins.DateExpires) {
+
+			return false
+		}
 
 		return true
 	}
@@ -2050,6 +2140,72 @@ func (meta *RuleEntryMeta) WriteMetaTo(writer io.Writer) error {
 
 		return err
 	}
+	err = WriteStringToWriter(writer, meta.Declaration.Resource)
+	if err != nil {
+
+		return err
+	}
+	for _, i := range []int{meta.Declaration.ResourceIndex, meta.Declaration.Position, meta.Declaration.Line, meta.Declaration.Column} {
+		err = WriteIntToWriter(writer, uint64(i))
+		if err != nil {
+
+			return err
+		}
+	}
+	err = WriteStringToWriter(writer, meta.AgendaGroup)
+	if err != nil {
+
+		return err
+	}
+	err = WriteBoolToWriter(writer, meta.NoLoop)
+	if err != nil {
+
+		return err
+	}
+	err = WriteBoolToWriter(writer, meta.LockOnActive)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.ActivationGroup)
+	if err != nil {
+
+		return err
+	}
+	keys := make([]string, 0, len(meta.Annotations))
+	for key := range meta.Annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	err = WriteIntToWriter(writer, uint64(len(keys)))
+	if err != nil {
+
+		return err
+	}
+	for _, key := range keys {
+		err = WriteStringToWriter(writer, key)
+		if err != nil {
+
+			return err
+		}
+		err = WriteStringToWriter(writer, meta.Annotations[key])
+		if err != nil {
+
+			return err
+		}
+	}
+	err = WriteBoolToWriter(writer, meta.Disabled)
+	if err != nil {
+
+		return err
+	}
+	for _, date := range []time.Time{meta.DateEffective, meta.DateExpires} {
+		err = WriteStringToWriter(writer, formatMetaTime(date))
+		if err != nil {
+
+			return err
+		}
+	}
 
 	return nil
 }
@@ -2093,10 +2249,104 @@ func (meta *RuleEntryMeta) ReadMetaFrom(reader io.Reader) error {
 		return err
 	}
 	meta.ThenScopeID = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Declaration.Resource = stringFromReader
+	for _, field := range []*int{&meta.Declaration.ResourceIndex, &meta.Declaration.Position, &meta.Declaration.Line, &meta.Declaration.Column} {
+		i, err = ReadIntFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+		*field = int(i)
+	}
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.AgendaGroup = stringFromReader
+	meta.NoLoop, err = ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.LockOnActive, err = ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ActivationGroup = stringFromReader
+	count, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	if count > 0 {
+		meta.Annotations = make(map[string]string, count)
+	}
+	for ; count > 0; count-- {
+		key, err := ReadStringFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+		value, err := ReadStringFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+		meta.Annotations[key] = value
+	}
+	meta.Disabled, err = ReadBoolFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	for _, date := range []*time.Time{&meta.DateEffective, &meta.DateExpires} {
+		stringFromReader, err = ReadStringFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+		*date, err = parseMetaTime(stringFromReader)
+		if err != nil {
+
+			return err
+		}
+	}
 
 	return nil
 }
 
+// formatMetaTime formats a time to be written into the catalog, a zero time is written as an empty string.
+func formatMetaTime(t time.Time) string {
+	if t.IsZero() {
+
+		return ""
+	}
+
+	return t.Format(time.RFC3339Nano)
+}
+
+// parseMetaTime parses a time written by formatMetaTime.
+func parseMetaTime(s string) (time.Time, error) {
+	if len(s) == 0 {
+
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339Nano, s)
+}
+
 // ThenExpressionMeta meta data for an ThenExpression node
 type ThenExpressionMeta struct {
 	NodeMeta
@@ -2508,6 +2758,174 @@ func (meta *WhenScopeMeta) ReadMetaFrom(reader io.Reader) error {
 	return nil
 }
 
+// QueryEntryMeta meta data for an QueryEntry node
+type QueryEntryMeta struct {
+	NodeMeta
+
+	QueryName        string
+	QueryDescription string
+	Parameters       []string
+	ExpressionID     string
+	Declaration      Declaration
+}
+
+// Equals basic function to test equality of two MetaNode
+func (meta *QueryEntryMeta) Equals(that Meta) bool {
+	if ins, ok := that.(*QueryEntryMeta); ok {
+		if !meta.NodeMeta.Equals(that) {
+
+			return false
+		}
+		if meta.QueryName != ins.QueryName {
+
+			return false
+		}
+		if meta.QueryDescription != ins.QueryDescription {
+
+			return false
+		}
+		if len(meta.Parameters) != len(ins.Parameters) {
+
+			return false
+		}
+		for i, parameter := range meta.Parameters {
+			if ins.Parameters[i] != parameter {
+
+				return false
+			}
+		}
+		if meta.ExpressionID != ins.ExpressionID {
+
+			return false
+		}
+		if meta.Declaration != ins.Declaration {
+
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// GetASTType returns the meta type of this AST Node
+func (meta *QueryEntryMeta) GetASTType() NodeType {
+
+	return TypeQueryEntry
+}
+
+// WriteMetaTo write basic AST Node information meta data into writer.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *QueryEntryMeta) WriteMetaTo(writer io.Writer) error {
+	err := meta.NodeMeta.WriteMetaTo(writer)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.QueryName)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.QueryDescription)
+	if err != nil {
+
+		return err
+	}
+	err = WriteIntToWriter(writer, uint64(len(meta.Parameters)))
+	if err != nil {
+
+		return err
+	}
+	for _, parameter := range meta.Parameters {
+		err = WriteStringToWriter(writer, parameter)
+		if err != nil {
+
+			return err
+		}
+	}
+	err = WriteStringToWriter(writer, meta.ExpressionID)
+	if err != nil {
+
+		return err
+	}
+	err = WriteStringToWriter(writer, meta.Declaration.Resource)
+	if err != nil {
+
+		return err
+	}
+	for _, i := range []int{meta.Declaration.ResourceIndex, meta.Declaration.Position, meta.Declaration.Line, meta.Declaration.Column} {
+		err = WriteIntToWriter(writer, uint64(i))
+		if err != nil {
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadMetaFrom write basic AST Node information meta data from reader.
+// One should not use this function directly, unless for testing
+// serialization of single ASTNode.
+func (meta *QueryEntryMeta) ReadMetaFrom(reader io.Reader) error {
+	err := meta.NodeMeta.ReadMetaFrom(reader)
+	if err != nil {
+
+		return err
+	}
+	stringFromReader, err := ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.QueryName = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.QueryDescription = stringFromReader
+	count, err := ReadIntFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Parameters = make([]string, count)
+	for i := range meta.Parameters {
+		stringFromReader, err = ReadStringFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+		meta.Parameters[i] = stringFromReader
+	}
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.ExpressionID = stringFromReader
+	stringFromReader, err = ReadStringFromReader(reader)
+	if err != nil {
+
+		return err
+	}
+	meta.Declaration.Resource = stringFromReader
+	for _, field := range []*int{&meta.Declaration.ResourceIndex, &meta.Declaration.Position, &meta.Declaration.Line, &meta.Declaration.Column} {
+		i, err := ReadIntFromReader(reader)
+		if err != nil {
+
+			return err
+		}
+		*field = int(i)
+	}
+
+	return nil
+}
+
 var (
 	// TotalRead counter to track total byte read
 	TotalRead = uint64(0)
@@ -34,6 +34,16 @@ func TestReadWriteString(t *testing.T) {
 	assert.Equal(t, str, str2)
 }
 
+func TestTypeValues(t *testing.T) {
+	// the types are written in the catalogs, their values must never change.
+	assert.Equal(t, NodeType(12), TypeWhenScope)
+	assert.Equal(t, ValueType(13), TypeString)
+	assert.Equal(t, ValueType(14), TypeInteger)
+	assert.Equal(t, ValueType(15), TypeFloat)
+	assert.Equal(t, ValueType(16), TypeBoolean)
+	assert.Equal(t, NodeType(17), TypeQueryEntry)
+}
+
 func TestAssigmentMetaReadWrite(t *testing.T) {
 	assigment := &AssigmentMeta{
 		NodeMeta: NodeMeta{
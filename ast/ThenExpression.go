@@ -167,3 +167,13 @@ func (e *ThenExpression) Execute(dataContext IDataContext, memory *WorkingMemory
 
 	return nil
 }
+
+// collectFactNames adds the names of the facts this then expression refers to.
+func (e *ThenExpression) collectFactNames(names map[string]bool) {
+	if e.Assignment != nil {
+		e.Assignment.collectFactNames(names)
+	}
+	if e.ExpressionAtom != nil {
+		e.ExpressionAtom.collectFactNames(names)
+	}
+}
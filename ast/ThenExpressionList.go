@@ -143,3 +143,33 @@ func (e *ThenExpressionList) Execute(dataContext IDataContext, memory *WorkingMe
 
 	return nil
 }
+
+// collectFactNames adds the names of the facts these then expressions refer to.
+func (e *ThenExpressionList) collectFactNames(names map[string]bool) {
+	for _, expression := range e.ThenExpressions {
+		expression.collectFactNames(names)
+	}
+}
+
+// collectAssignedVariableNames adds the variables the then expressions assign.
+func (e *ThenExpressionList) collectAssignedVariableNames(names map[string]bool) {
+	for _, expression := range e.ThenExpressions {
+		if expression.Assignment != nil && expression.Assignment.Variable != nil {
+			names[expression.Assignment.Variable.GrlText] = true
+		}
+	}
+}
+
+// collectChangedVariableNames adds the variables the then expressions assign, and the variables the function and
+// method calls they make may change.
+func (e *ThenExpressionList) collectChangedVariableNames(names map[string]bool) {
+	e.collectAssignedVariableNames(names)
+	for _, expression := range e.ThenExpressions {
+		if expression.Assignment != nil && expression.Assignment.Expression != nil {
+			expression.Assignment.Expression.collectChangedVariableNames(names)
+		}
+		if expression.ExpressionAtom != nil {
+			expression.ExpressionAtom.collectChangedVariableNames(names)
+		}
+	}
+}
@@ -121,6 +121,24 @@ func (e *ThenScope) Execute(dataContext IDataContext, memory *WorkingMemory) err
 	if e.ThenExpressionList == nil {
 		AstLog.Warnf("Can not execute nil expression list")
 	}
+	if intercepting, ok := dataContext.(InterceptingDataContext); ok {
+		intercepting.ExecutingThenScope(true)
+		defer intercepting.ExecutingThenScope(false)
+	}
 
 	return e.ThenExpressionList.Execute(dataContext, memory)
 }
+
+// collectFactNames adds the names of the facts this then scope refers to.
+func (e *ThenScope) collectFactNames(names map[string]bool) {
+	if e.ThenExpressionList != nil {
+		e.ThenExpressionList.collectFactNames(names)
+	}
+}
+
+// collectAssignedVariableNames adds the variables this then scope assigns.
+func (e *ThenScope) collectAssignedVariableNames(names map[string]bool) {
+	if e.ThenExpressionList != nil {
+		e.ThenExpressionList.collectAssignedVariableNames(names)
+	}
+}
@@ -0,0 +1,193 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package ast
+
+import (
+	"reflect"
+
+	"github.com/hyperjumptech/grule-rule-engine/model"
+)
+
+// NewTransactionDataContext creates a data context journaling the changes made to the facts of the specified data context.
+func NewTransactionDataContext(dataContext IDataContext) *TransactionDataContext {
+
+	return &TransactionDataContext{
+		IDataContext: dataContext,
+		journal:      model.NewJournal(),
+	}
+}
+
+// TransactionDataContext is a data context whose facts are changed through journaled value nodes, so the changes
+// made by the assignments and the Append function can be rolled back. The facts added, replaced or retracted by the
+// rule entries are journaled too. The changes a fact's own function makes are not.
+type TransactionDataContext struct {
+	IDataContext
+
+	journal *model.Journal
+}
+
+// Get returns the journaled value node of the fact.
+func (ctx *TransactionDataContext) Get(key string) model.ValueNode {
+	valueNode := ctx.IDataContext.Get(key)
+	if valueNode == nil {
+
+		return nil
+	}
+
+	return model.NewJournaledValueNode(valueNode, ctx.journal)
+}
+
+// Add adds the fact, the fact it replaces is journaled, or its absence.
+func (ctx *TransactionDataContext) Add(key string, obj interface{}) error {
+	if key == "DEFUNC" {
+
+		return ctx.IDataContext.Add(key, obj)
+	}
+	undo := ctx.undoAdd(key)
+	err := ctx.IDataContext.Add(key, obj)
+	if err == nil {
+		ctx.journal.Record(undo)
+	}
+
+	return err
+}
+
+// AddJSON adds the JSON fact, the fact it replaces is journaled, or its absence.
+func (ctx *TransactionDataContext) AddJSON(key string, JSON []byte) error {
+	undo := ctx.undoAdd(key)
+	err := ctx.IDataContext.AddJSON(key, JSON)
+	if err == nil {
+		ctx.journal.Record(undo)
+	}
+
+	return err
+}
+
+// undoAdd returns the function restoring the fact as it is before it's added, retracted or not. A fact that didn't
+// exist is removed from a DataContext, and retracted from any other IDataContext.
+func (ctx *TransactionDataContext) undoAdd(key string) func() error {
+	oldNode := ctx.IDataContext.Get(key)
+	retracted := ctx.IDataContext.IsRetracted(key)
+
+	return func() error {
+		if dataContext, ok := ctx.IDataContext.(*DataContext); ok {
+			if oldNode == nil {
+				delete(dataContext.ObjectStore, key)
+			} else {
+				dataContext.ObjectStore[key] = oldNode
+			}
+			if retracted {
+				dataContext.Retract(key)
+			}
+
+			return nil
+		}
+		if oldNode == nil {
+			ctx.IDataContext.Retract(key)
+
+			return nil
+		}
+		err := ctx.IDataContext.Add(key, oldNode.Value().Interface())
+		if err == nil && retracted {
+			ctx.IDataContext.Retract(key)
+		}
+
+		return err
+	}
+}
+
+// Retract retracts the fact, the retraction is journaled.
+func (ctx *TransactionDataContext) Retract(key string) {
+	if ctx.IDataContext.IsRetracted(key) {
+
+		return
+	}
+	ctx.IDataContext.Retract(key)
+	oldNode := ctx.IDataContext.Get(key)
+	ctx.journal.Record(func() error {
+		if dataContext, ok := ctx.IDataContext.(*DataContext); ok {
+			dataContext.unretract(key)
+
+			return nil
+		}
+		if oldNode == nil {
+
+			return nil
+		}
+
+		return ctx.IDataContext.Add(key, oldNode.Value().Interface())
+	})
+}
+
+// GetObserver returns the ExecutionObserver of the data context it journals, nil if it has none or can't hold one.
+func (ctx *TransactionDataContext) GetObserver() ExecutionObserver {
+
+	return observerOf(ctx.IDataContext)
+}
+
+// SetObserver sets the ExecutionObserver into the data context it journals, if it can hold one.
+func (ctx *TransactionDataContext) SetObserver(observer ExecutionObserver) {
+	if observable, ok := ctx.IDataContext.(ObservableDataContext); ok {
+		observable.SetObserver(observer)
+	}
+}
+
+// AssignVariable assigns the value to the variable through the journaled value nodes, or lets the data context it
+// journals assign it if that one intercepts the changes.
+func (ctx *TransactionDataContext) AssignVariable(variable *Variable, newVal reflect.Value, memory *WorkingMemory) error {
+	if intercepting, ok := ctx.IDataContext.(InterceptingDataContext); ok {
+
+		return intercepting.AssignVariable(variable, newVal, memory)
+	}
+
+	return variable.assign(newVal, ctx, memory)
+}
+
+// CallFunction calls the function on the journaled value node, or lets the data context it journals call it if that
+// one intercepts the changes.
+func (ctx *TransactionDataContext) CallFunction(atom *ExpressionAtom, memory *WorkingMemory) (reflect.Value, model.ValueNode, error) {
+	if intercepting, ok := ctx.IDataContext.(InterceptingDataContext); ok {
+
+		return intercepting.CallFunction(atom, memory)
+	}
+	val, valueNode, _, err := atom.evaluateMethodCall(ctx, memory)
+
+	return val, valueNode, err
+}
+
+// ExecutingThenScope tells the data context it journals whether a then scope is being executed, if that one
+// intercepts the changes.
+func (ctx *TransactionDataContext) ExecutingThenScope(executing bool) {
+	if intercepting, ok := ctx.IDataContext.(InterceptingDataContext); ok {
+		intercepting.ExecutingThenScope(executing)
+	}
+}
+
+// Changes returns the number of changes journaled.
+func (ctx *TransactionDataContext) Changes() int {
+
+	return ctx.journal.Len()
+}
+
+// Commit keeps the changes made to the facts, they can't be rolled back anymore.
+func (ctx *TransactionDataContext) Commit() {
+	ctx.journal.Commit()
+}
+
+// Rollback reverts the changes made to the facts since the transaction started or was last committed.
+func (ctx *TransactionDataContext) Rollback() error {
+
+	return ctx.journal.Rollback()
+}
@@ -40,9 +40,6 @@ type Variable struct {
 	Name             string
 	Variable         *Variable
 	ArrayMapSelector *ArrayMapSelector
-
-	ValueNode model.ValueNode
-	Value     reflect.Value
 }
 
 // MakeCatalog create a catalog entry for this AST Node
@@ -164,6 +161,16 @@ func (e *Variable) SetGrlText(grlText string) {
 
 // Assign will assign the specified value to the variable
 func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory *WorkingMemory) error {
+	if intercepting, ok := dataContext.(InterceptingDataContext); ok {
+
+		return intercepting.AssignVariable(e, newVal, memory)
+	}
+
+	return e.assign(newVal, dataContext, memory)
+}
+
+// assign assigns the specified value to the variable in the facts of the data context.
+func (e *Variable) assign(newVal reflect.Value, dataContext IDataContext, memory *WorkingMemory) error {
 	if len(e.Name) > 0 && e.Variable == nil {
 		err := dataContext.Add(e.Name, pkg.ValueToInterface(newVal))
 		if err == nil {
@@ -173,11 +180,11 @@ func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory
 		return err
 	}
 	if e.Variable != nil && len(e.Name) > 0 {
-		_, err := e.Variable.Evaluate(dataContext, memory)
+		parentNode, err := e.Variable.evaluateValueNode(dataContext, memory)
 		if err != nil {
 			return err
 		}
-		err = e.Variable.ValueNode.SetObjectValueByField(e.Name, newVal)
+		err = parentNode.SetObjectValueByField(e.Name, newVal)
 		if err == nil {
 			dataContext.IncrementVariableChangeCount()
 			memory.ResetVariable(e)
@@ -186,26 +193,26 @@ func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory
 		return err
 	}
 	if e.Variable != nil && e.ArrayMapSelector != nil {
-		_, err := e.Variable.Evaluate(dataContext, memory)
+		parentNode, err := e.Variable.evaluateValueNode(dataContext, memory)
 		if err != nil {
 
 			return err
 		}
-		_, err = e.ArrayMapSelector.Evaluate(dataContext, memory)
+		selValue, err := e.ArrayMapSelector.Evaluate(dataContext, memory)
 		if err != nil {
 
 			return err
 		}
-		if e.Variable.ValueNode.IsArray() {
-			err := e.Variable.ValueNode.SetArrayValueAt(int(e.ArrayMapSelector.Value.Int()), newVal)
+		if parentNode.IsArray() {
+			err := parentNode.SetArrayValueAt(int(selValue.Int()), newVal)
 			if err == nil {
 				memory.ResetVariable(e)
 			}
 
 			return err
 		}
-		if e.Variable.ValueNode.IsMap() {
-			err := e.Variable.ValueNode.SetMapValueAt(e.ArrayMapSelector.Value, newVal)
+		if parentNode.IsMap() {
+			err := parentNode.SetMapValueAt(selValue, newVal)
 			if err == nil {
 				memory.ResetVariable(e)
 			}
@@ -219,67 +226,110 @@ func (e *Variable) Assign(newVal reflect.Value, dataContext IDataContext, memory
 
 // Evaluate will evaluate this AST graph for when scope evaluation
 func (e *Variable) Evaluate(dataContext IDataContext, memory *WorkingMemory) (reflect.Value, error) {
+	valueNode, err := e.evaluateValueNode(dataContext, memory)
+	if err != nil {
+
+		return reflect.ValueOf(nil), err
+	}
+
+	return valueNode.Value(), nil
+}
+
+// evaluateValueNode evaluates this AST graph into the value node of the variable.
+// A variable is never cached by the working memory, it always reflects the current facts.
+func (e *Variable) evaluateValueNode(dataContext IDataContext, memory *WorkingMemory) (model.ValueNode, error) {
 	if len(e.Name) > 0 && e.Variable == nil {
 		valueNode := dataContext.Get(e.Name)
 		if valueNode == nil {
 
-			return reflect.ValueOf(nil), fmt.Errorf("non existent key %s", e.Name)
+			return nil, fmt.Errorf("non existent key %s", e.Name)
 		}
-		e.ValueNode = valueNode
-		e.Value = valueNode.Value()
 
-		return e.Value, nil
+		return valueNode, nil
 	}
 	if e.Variable != nil && len(e.Name) > 0 {
-		_, err := e.Variable.Evaluate(dataContext, memory)
+		parentNode, err := e.Variable.evaluateValueNode(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return nil, err
 		}
-		valueNode, err := e.Variable.ValueNode.GetChildNodeByField(e.Name)
-		if err != nil {
-
-			return reflect.Value{}, err
-		}
-		e.ValueNode = valueNode
-		e.Value = valueNode.Value()
 
-		return e.Value, nil
+		return parentNode.GetChildNodeByField(e.Name)
 	}
 	if e.Variable != nil && e.ArrayMapSelector != nil {
-		_, err := e.Variable.Evaluate(dataContext, memory)
+		parentNode, err := e.Variable.evaluateValueNode(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return nil, err
 		}
 		selValue, err := e.ArrayMapSelector.Evaluate(dataContext, memory)
 		if err != nil {
 
-			return reflect.Value{}, err
+			return nil, err
 		}
-		var valueNode model.ValueNode
-		if e.Variable.ValueNode.IsArray() {
-			valueNode, err = e.Variable.ValueNode.GetChildNodeByIndex(int(selValue.Int()))
-			if err != nil {
+		if parentNode.IsArray() {
 
-				return reflect.Value{}, err
-			}
-		} else if e.Variable.ValueNode.IsMap() {
-			valueNode, err = e.Variable.ValueNode.GetChildNodeBySelector(selValue)
-			if err != nil {
-
-				return reflect.Value{}, err
-			}
-		} else {
+			return parentNode.GetChildNodeByIndex(int(selValue.Int()))
+		}
+		if parentNode.IsMap() {
 
-			return reflect.Value{}, fmt.Errorf("%s is not an array nor map", e.Variable.ValueNode.IdentifiedAs())
+			return parentNode.GetChildNodeBySelector(selValue)
 		}
 
-		e.ValueNode = valueNode
-		e.Value = valueNode.Value()
+		return nil, fmt.Errorf("%s is not an array nor map", parentNode.IdentifiedAs())
+	}
+
+	return nil, fmt.Errorf("this code part should not be reached")
+}
 
-		return e.Value, nil
+// changePath evaluates the array and map selectors of this AST graph into the path of the variable in the facts.
+func (e *Variable) changePath(dataContext IDataContext, memory *WorkingMemory) (*factPath, error) {
+	if len(e.Name) > 0 && e.Variable == nil {
+
+		return &factPath{fact: e.Name}, nil
+	}
+	if e.Variable == nil {
+
+		return nil, fmt.Errorf("this code part should not be reached")
+	}
+	parentPath, err := e.Variable.changePath(dataContext, memory)
+	if err != nil {
+
+		return nil, err
+	}
+	if len(e.Name) > 0 {
+
+		return parentPath.field(e.Name), nil
+	}
+	selValue, err := e.ArrayMapSelector.Evaluate(dataContext, memory)
+	if err != nil {
+
+		return nil, err
+	}
+
+	return parentPath.selector(selValue), nil
+}
+
+// collectFactNames adds the name of the fact this variable belongs to.
+func (e *Variable) collectFactNames(names map[string]bool) {
+	if len(e.Name) > 0 && e.Variable == nil {
+		names[e.Name] = true
+	}
+	if e.Variable != nil {
+		e.Variable.collectFactNames(names)
 	}
+	if e.ArrayMapSelector != nil {
+		e.ArrayMapSelector.collectFactNames(names)
+	}
+}
 
-	return reflect.ValueOf(nil), fmt.Errorf("this code part should not be reached")
+// collectVariableNames adds this variable, as written in the GRL, and the variables its selectors read.
+// The variables it's a member or an element of are not added.
+func (e *Variable) collectVariableNames(names map[string]bool) {
+	names[e.GrlText] = true
+	for variable := e; variable != nil; variable = variable.Variable {
+		if variable.ArrayMapSelector != nil {
+			variable.ArrayMapSelector.collectVariableNames(names)
+		}
+	}
 }
@@ -128,3 +128,10 @@ func (e *WhenScope) Evaluate(dataContext IDataContext, memory *WorkingMemory) (r
 
 	return e.Expression.Evaluate(dataContext, memory)
 }
+
+// collectFactNames adds the names of the facts this when scope refers to.
+func (e *WhenScope) collectFactNames(names map[string]bool) {
+	if e.Expression != nil {
+		e.Expression.collectFactNames(names)
+	}
+}
@@ -18,8 +18,11 @@ import (
 	"fmt"
 	"github.com/hyperjumptech/grule-rule-engine/ast/unique"
 	"github.com/hyperjumptech/grule-rule-engine/logger"
+	"github.com/hyperjumptech/grule-rule-engine/model"
 	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -35,9 +38,20 @@ func NewWorkingMemory(name, version string) *WorkingMemory {
 		expressionVariableMap:     make(map[*Variable][]*Expression),
 		expressionAtomVariableMap: make(map[*Variable][]*ExpressionAtom),
 		ID:                        unique.NewID(),
+		expressionStates:          make(map[*Expression]*nodeState),
+		atomStates:                make(map[*ExpressionAtom]*nodeState),
 	}
 }
 
+// nodeState is the value an expression or an expression atom evaluated to, cached by the working memory.
+type nodeState struct {
+	// lock guards the evaluation of the node when the working memory is evaluated concurrently.
+	lock      sync.Mutex
+	value     reflect.Value
+	valueNode model.ValueNode
+	evaluated bool
+}
+
 // WorkingMemory handles states of expression evaluation status
 type WorkingMemory struct {
 	Name                      string
@@ -48,6 +62,149 @@ type WorkingMemory struct {
 	expressionVariableMap     map[*Variable][]*Expression
 	expressionAtomVariableMap map[*Variable][]*ExpressionAtom
 	ID                        string
+	concurrent                bool
+
+	// expressionStates and atomStates are the values cached for the AST nodes, so the nodes themselves are never
+	// changed by an execution. They're keyed by the node type, so the lookups are done on a pointer.
+	expressionStates map[*Expression]*nodeState
+	atomStates       map[*ExpressionAtom]*nodeState
+	statesLock       sync.RWMutex
+	// origin is the working memory whose index this session shares, nil if it's not a session.
+	origin *WorkingMemory
+	// matcher is notified of the nodes forgotten during an execution using a RETE network, nil otherwise.
+	matcher *ReteMatcher
+}
+
+// NewSession creates a working memory sharing the index of this working memory, but with its own cached values.
+// Many sessions can evaluate the same AST nodes at once.
+func (workingMem *WorkingMemory) NewSession() *WorkingMemory {
+
+	return &WorkingMemory{
+		Name:                      workingMem.Name,
+		Version:                   workingMem.Version,
+		expressionSnapshotMap:     workingMem.expressionSnapshotMap,
+		expressionAtomSnapshotMap: workingMem.expressionAtomSnapshotMap,
+		variableSnapshotMap:       workingMem.variableSnapshotMap,
+		expressionVariableMap:     workingMem.expressionVariableMap,
+		expressionAtomVariableMap: workingMem.expressionAtomVariableMap,
+		ID:                        unique.NewID(),
+		expressionStates:          make(map[*Expression]*nodeState),
+		atomStates:                make(map[*ExpressionAtom]*nodeState),
+		origin:                    workingMem.index(),
+	}
+}
+
+// index returns the working memory owning the index of this working memory.
+func (workingMem *WorkingMemory) index() *WorkingMemory {
+	if workingMem.origin != nil {
+
+		return workingMem.origin
+	}
+
+	return workingMem
+}
+
+// SharesIndex returns true if this working memory and that one are the same working memory or sessions of it.
+func (workingMem *WorkingMemory) SharesIndex(that *WorkingMemory) bool {
+
+	return that != nil && workingMem.index() == that.index()
+}
+
+// expressionState returns the cached state of the expression, it's created if the expression has not been
+// evaluated yet.
+func (workingMem *WorkingMemory) expressionState(expression *Expression) *nodeState {
+	if workingMem.concurrent {
+		workingMem.statesLock.RLock()
+		state, ok := workingMem.expressionStates[expression]
+		workingMem.statesLock.RUnlock()
+		if ok {
+
+			return state
+		}
+		workingMem.statesLock.Lock()
+		defer workingMem.statesLock.Unlock()
+	}
+	if workingMem.expressionStates == nil {
+		workingMem.expressionStates = make(map[*Expression]*nodeState)
+	}
+	state, ok := workingMem.expressionStates[expression]
+	if !ok {
+		state = &nodeState{}
+		workingMem.expressionStates[expression] = state
+	}
+
+	return state
+}
+
+// atomState returns the cached state of the expression atom, it's created if the expression atom has not been
+// evaluated yet.
+func (workingMem *WorkingMemory) atomState(atom *ExpressionAtom) *nodeState {
+	if workingMem.concurrent {
+		workingMem.statesLock.RLock()
+		state, ok := workingMem.atomStates[atom]
+		workingMem.statesLock.RUnlock()
+		if ok {
+
+			return state
+		}
+		workingMem.statesLock.Lock()
+		defer workingMem.statesLock.Unlock()
+	}
+	if workingMem.atomStates == nil {
+		workingMem.atomStates = make(map[*ExpressionAtom]*nodeState)
+	}
+	state, ok := workingMem.atomStates[atom]
+	if !ok {
+		state = &nodeState{}
+		workingMem.atomStates[atom] = state
+	}
+
+	return state
+}
+
+// cachedState returns the cached state of the expression or expression atom, nil if it has none.
+func (workingMem *WorkingMemory) cachedState(node interface{}) *nodeState {
+	switch node := node.(type) {
+	case *Expression:
+
+		return workingMem.expressionStates[node]
+	case *ExpressionAtom:
+
+		return workingMem.atomStates[node]
+	}
+
+	return nil
+}
+
+// forget marks the AST node as not evaluated.
+func (workingMem *WorkingMemory) forget(node interface{}) {
+	if state := workingMem.cachedState(node); state != nil {
+		state.evaluated = false
+	}
+	if workingMem.matcher != nil {
+		workingMem.matcher.forgotten(node)
+	}
+}
+
+// EvaluatedValue returns the value of the expression if it's cached by the working memory.
+func (workingMem *WorkingMemory) EvaluatedValue(expression *Expression) (reflect.Value, bool) {
+	if state, ok := workingMem.expressionStates[expression]; ok && state.evaluated {
+
+		return state.value, true
+	}
+
+	return reflect.Value{}, false
+}
+
+// EvaluatedAtomValue returns the value of the expression atom and the value node holding it, if they're cached by
+// the working memory.
+func (workingMem *WorkingMemory) EvaluatedAtomValue(atom *ExpressionAtom) (reflect.Value, model.ValueNode, bool) {
+	if state, ok := workingMem.atomStates[atom]; ok && state.evaluated {
+
+		return state.value, state.valueNode, true
+	}
+
+	return reflect.Value{}, nil, false
 }
 
 // MakeCatalog create a catalog entry of this working memory
@@ -328,12 +485,12 @@ func (workingMem *WorkingMemory) Reset(name string) bool {
 	}
 	for snap, expr := range workingMem.expressionSnapshotMap {
 		if strings.Contains(snap, name) || strings.Contains(expr.GrlText, name) {
-			expr.Evaluated = false
+			workingMem.forget(expr)
 		}
 	}
 	for snap, expr := range workingMem.expressionAtomSnapshotMap {
 		if strings.Contains(snap, name) || strings.Contains(expr.GrlText, name) {
-			expr.Evaluated = false
+			workingMem.forget(expr)
 		}
 	}
 
@@ -351,7 +508,7 @@ func (workingMem *WorkingMemory) ResetVariable(variable *Variable) bool {
 	if arr, ok := workingMem.expressionVariableMap[variable]; ok {
 		for _, expr := range arr {
 			AstLog.Tracef("------ reset expr : %s", expr.GrlText)
-			expr.Evaluated = false
+			workingMem.forget(expr)
 			reseted = true
 		}
 	} else {
@@ -360,7 +517,7 @@ func (workingMem *WorkingMemory) ResetVariable(variable *Variable) bool {
 	if arr, ok := workingMem.expressionAtomVariableMap[variable]; ok {
 		for _, expr := range arr {
 			AstLog.Tracef("------ reset expr atm : %s", expr.GrlText)
-			expr.Evaluated = false
+			workingMem.forget(expr)
 			reseted = true
 		}
 	} else {
@@ -370,18 +527,43 @@ func (workingMem *WorkingMemory) ResetVariable(variable *Variable) bool {
 	return reseted
 }
 
+// SetConcurrent tells whether the expressions are about to be evaluated by many goroutines at once.
+// While it's set, each expression evaluation locks its cached state, so a node shared by many rule entries is
+// evaluated once and its cached value is safe to read. It must not be changed during an evaluation.
+func (workingMem *WorkingMemory) SetConcurrent(concurrent bool) {
+	workingMem.concurrent = concurrent
+}
+
+// IsConcurrent returns true if the expressions are evaluated by many goroutines at once.
+func (workingMem *WorkingMemory) IsConcurrent() bool {
+
+	return workingMem != nil && workingMem.concurrent
+}
+
 // ResetAll sets all expression evaluated status to false.
 // Returns true if any expression was reset, false if otherwise
 func (workingMem *WorkingMemory) ResetAll() bool {
-	reseted := false
-	for _, expr := range workingMem.expressionSnapshotMap {
-		expr.Evaluated = false
-		reseted = true
+	for _, state := range workingMem.expressionStates {
+		state.evaluated = false
 	}
-	for _, expr := range workingMem.expressionAtomSnapshotMap {
-		expr.Evaluated = false
-		reseted = true
+	for _, state := range workingMem.atomStates {
+		state.evaluated = false
+	}
+	if workingMem.matcher != nil {
+		workingMem.matcher.reset()
 	}
 
-	return reseted
+	return len(workingMem.expressionSnapshotMap) > 0 || len(workingMem.expressionAtomSnapshotMap) > 0
+}
+
+// EvaluatedExpressions returns the values of the expressions whose evaluation is currently cached, by their GRL text.
+func (workingMem *WorkingMemory) EvaluatedExpressions() map[string]reflect.Value {
+	values := make(map[string]reflect.Value)
+	for _, expr := range workingMem.expressionSnapshotMap {
+		if value, ok := workingMem.EvaluatedValue(expr); ok {
+			values[expr.GrlText] = value
+		}
+	}
+
+	return values
 }
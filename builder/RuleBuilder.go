@@ -83,6 +83,10 @@ func NewRuleBuilder(KnowledgeLibrary *ast.KnowledgeLibrary) *RuleBuilder {
 // RuleBuilder builds rule from GRL script into contained KnowledgeBase
 type RuleBuilder struct {
 	KnowledgeLibrary *ast.KnowledgeLibrary
+	// CheckFunctionCalls makes the build fail when a function called without a fact is neither a built-in function
+	// nor a function registered into the KnowledgeLibrary's Functions. It's off by default, as the functions given
+	// to GruleEngine.Functions are only known when the rules are executed.
+	CheckFunctionCalls bool
 }
 
 // MustBuildRuleFromResources is similar to BuildRuleFromResources, with the difference is, it will panic if rule script contains error.
@@ -165,6 +169,8 @@ func (builder *RuleBuilder) BuildRuleFromResource(name, version string, resource
 	}
 
 	listener := antlr2.NewGruleV3ParserListener(knowledgeBase, errReporter)
+	listener.Resource = resource.String()
+	listener.CheckFunctionCalls = builder.CheckFunctionCalls
 
 	psr := parser.Newgrulev3Parser(stream)
 
@@ -175,7 +181,7 @@ func (builder *RuleBuilder) BuildRuleFromResource(name, version string, resource
 	antlr.ParseTreeWalkerDefault.Walk(listener, psr.Grl())
 
 	grl := listener.Grl
-	for _, ruleEntry := range grl.RuleEntries {
+	for _, ruleEntry := range grl.OrderedRuleEntries() {
 		err := knowledgeBase.AddRuleEntry(ruleEntry)
 		if err != nil && err.Error() != "rule entry TestNoDesc already exist" {
 			BuilderLog.Tracef("warning while adding rule entry : %s. got %s, possibly already added by antlr listener", ruleEntry.RuleName, err.Error())
@@ -183,6 +189,7 @@ func (builder *RuleBuilder) BuildRuleFromResource(name, version string, resource
 	}
 
 	knowledgeBase.WorkingMemory.IndexVariables()
+	knowledgeBase.BuildReteNetwork()
 
 	// Get the loading duration.
 	dur := time.Now().Sub(startTime)
@@ -0,0 +1,109 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Command grule-debug executes the rules of a GRL file step by step, driven by commands typed in the
+// terminal. Each fact is a JSON file added to the data context under the given name. The execution
+// pauses before its first cycle, type `help` for the commands.
+//
+//	grule-debug -fact Order=order.json -fact Customer=customer.json rules.grl
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+// factFlags are the facts given as name=path flags.
+type factFlags map[string]string
+
+func (f factFlags) String() string {
+	facts := make([]string, 0, len(f))
+	for name, path := range f {
+		facts = append(facts, name+"="+path)
+	}
+
+	return strings.Join(facts, ",")
+}
+
+func (f factFlags) Set(value string) error {
+	index := strings.Index(value, "=")
+	if index <= 0 || index == len(value)-1 {
+
+		return fmt.Errorf("fact %s is not name=path", value)
+	}
+	f[value[:index]] = value[index+1:]
+
+	return nil
+}
+
+func main() {
+	facts := make(factFlags)
+	flag.Var(facts, "fact", "a `name=path` fact read from a JSON file, can be repeated")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-fact name=path]... rules.grl\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	result, err := debugFile(flag.Arg(0), facts)
+	if result != nil {
+		fmt.Printf("execution stopped after %d cycles, %s\n", result.Cycles, result.StopReason)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func debugFile(path string, facts factFlags) (*engine.ExecutionResult, error) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("Debug", "0.0.1", pkg.NewFileResource(path))
+	if err != nil {
+
+		return nil, fmt.Errorf("error while building %s. got %w", path, err)
+	}
+	knowledgeBase, err := lib.NewKnowledgeBaseInstance("Debug", "0.0.1")
+	if err != nil {
+
+		return nil, err
+	}
+	dataCtx := ast.NewDataContext()
+	for name, factPath := range facts {
+		data, err := os.ReadFile(factPath)
+		if err != nil {
+
+			return nil, err
+		}
+		err = dataCtx.AddJSON(name, data)
+		if err != nil {
+
+			return nil, fmt.Errorf("error while reading fact %s from %s. got %w", name, factPath, err)
+		}
+	}
+	session := newTerminalDebugSession(os.Stdin, os.Stdout)
+	session.BreakOnStart()
+
+	return engine.NewGruleEngine().Debug(context.Background(), dataCtx, knowledgeBase, session)
+}
@@ -0,0 +1,225 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+const debugTerminalHelp = `commands:
+  c, continue        run until the next breakpoint
+  s, step            run until the next pause point
+  a, abort           stop the execution
+  b <rule>           break before the rule is executed
+  w <condition>      break before a cycle when the condition is true
+  d <breakpoint>     delete a breakpoint, as listed by l
+  l                  list the breakpoints
+  p <expression>     print the value of an expression
+  facts              print the facts
+  candidates         print the candidates of the cycle
+  memory             print the values cached by the working memory
+  h, help            print this help
+`
+
+// newTerminalDebugSession creates a debug session driven by commands read line by line from the input,
+// eg. the standard input, it writes the state of the execution to the output. Type `help` for the commands.
+// The execution continues if the input ends.
+func newTerminalDebugSession(in io.Reader, out io.Writer) *engine.DebugSession {
+	scanner := bufio.NewScanner(in)
+	session := engine.NewDebugSession(nil)
+	session.Handler = func(pause *engine.DebugPause) engine.DebugCommand {
+		printDebugPause(out, pause)
+		for {
+			fmt.Fprint(out, "(grule) ")
+			if !scanner.Scan() {
+				fmt.Fprintln(out)
+
+				return engine.DebugContinue
+			}
+			command, argument := splitDebugCommand(scanner.Text())
+			switch command {
+			case "":
+			case "c", "continue":
+
+				return engine.DebugContinue
+			case "s", "step":
+
+				return engine.DebugStep
+			case "a", "abort":
+
+				return engine.DebugAbort
+			case "b", "break":
+				session.BreakOnRule(argument)
+				fmt.Fprintf(out, "breakpoint on rule %s\n", argument)
+			case "w", "when":
+				if breakpoint, err := breakWhen(session, argument); err != nil {
+					fmt.Fprintf(out, "error: %v\n", err)
+				} else {
+					fmt.Fprintf(out, "breakpoint when %s\n", breakpoint)
+				}
+			case "d", "delete":
+				if session.RemoveBreakpoint(argument) {
+					fmt.Fprintf(out, "breakpoint %s deleted\n", argument)
+				} else {
+					fmt.Fprintf(out, "no breakpoint %s\n", argument)
+				}
+			case "l", "list":
+				for _, breakpoint := range session.Breakpoints() {
+					fmt.Fprintln(out, breakpoint)
+				}
+			case "p", "print":
+				value, err := evaluate(pause, argument)
+				if err != nil {
+					fmt.Fprintf(out, "error: %v\n", err)
+				} else {
+					fmt.Fprintf(out, "%s = %s\n", argument, formatDebugValue(value))
+				}
+			case "facts":
+				facts := pause.Facts()
+				for _, name := range sortedKeys(facts) {
+					fmt.Fprintf(out, "%s = %s\n", name, formatDebugValue(reflect.ValueOf(facts[name])))
+				}
+			case "candidates":
+				if len(pause.Candidates) == 0 {
+					fmt.Fprintln(out, "no candidate yet, step to the execution of the cycle")
+				}
+				for i, candidate := range pause.Candidates {
+					fmt.Fprintf(out, "%d. %s salience %d\n", i+1, candidate.RuleEntry.RuleName, candidate.RuleEntry.Salience)
+				}
+			case "memory":
+				cached := pause.CachedValues()
+				texts := make([]string, 0, len(cached))
+				for text := range cached {
+					texts = append(texts, text)
+				}
+				sort.Strings(texts)
+				for _, text := range texts {
+					fmt.Fprintf(out, "%s = %s\n", text, formatDebugValue(cached[text]))
+				}
+			case "h", "help":
+				fmt.Fprint(out, debugTerminalHelp)
+			default:
+				fmt.Fprintf(out, "unknown command %s, type help for the commands\n", command)
+			}
+		}
+	}
+
+	return session
+}
+
+// compileExpression builds the GRL expression as the when scope of the rule entry named Expression.
+func compileExpression(expression string) (*ast.KnowledgeBase, error) {
+	lib := ast.NewKnowledgeLibrary()
+	grl := fmt.Sprintf("rule Expression {\n when\n %s\n then\n Complete();\n}", expression)
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("Expression", "0.0.0", pkg.NewBytesResource([]byte(grl)))
+	if err != nil {
+
+		return nil, fmt.Errorf("invalid expression %s. got %w", expression, err)
+	}
+
+	return lib.GetKnowledgeBase("Expression", "0.0.0"), nil
+}
+
+// breakWhen adds the GRL condition as a condition breakpoint of the session, it returns the name of the breakpoint.
+func breakWhen(session *engine.DebugSession, condition string) (string, error) {
+	knowledge, err := compileExpression(condition)
+	if err != nil {
+
+		return "", err
+	}
+	err = session.BreakWhen(knowledge, "Expression")
+	if err != nil {
+
+		return "", err
+	}
+
+	return knowledge.RuleEntries["Expression"].WhenScope.Expression.GetGrlText(), nil
+}
+
+// evaluate evaluates the GRL expression against the facts of the paused execution.
+func evaluate(pause *engine.DebugPause, expression string) (reflect.Value, error) {
+	knowledge, err := compileExpression(expression)
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+
+	return pause.Evaluate(knowledge, "Expression")
+}
+
+// printDebugPause writes where the execution paused.
+func printDebugPause(out io.Writer, pause *engine.DebugPause) {
+	switch pause.Point {
+	case engine.DebugBeforeCycle:
+		fmt.Fprintf(out, "paused before cycle %d, agenda group %s", pause.Cycle, pause.AgendaGroup)
+	case engine.DebugBeforeExecute:
+		fmt.Fprintf(out, "paused before executing %s in cycle %d", pause.Rule.RuleName, pause.Cycle)
+	}
+	if len(pause.Breakpoint) > 0 {
+		fmt.Fprintf(out, ", breakpoint %s", pause.Breakpoint)
+	}
+	fmt.Fprintln(out)
+}
+
+// splitDebugCommand splits a line into the command and its argument.
+func splitDebugCommand(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	index := strings.IndexAny(line, " \t")
+	if index < 0 {
+
+		return line, ""
+	}
+
+	return line[:index], strings.TrimSpace(line[index+1:])
+}
+
+// formatDebugValue formats a value, dereferencing the pointers so the facts print their fields.
+func formatDebugValue(value reflect.Value) string {
+	for value.IsValid() && value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || !value.CanInterface() {
+
+		return "<nil>"
+	}
+	if value.Kind() == reflect.String {
+
+		return strconv.Quote(value.String())
+	}
+
+	return fmt.Sprintf("%+v", value.Interface())
+}
+
+// sortedKeys returns the keys of the map in order.
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,109 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const terminalGRL = `
+rule First "first" salience 10 {
+	when
+		Fact.Count == 0
+	then
+		Fact.Count = 1;
+}
+
+rule Second "second" {
+	when
+		Fact.Count == 1
+	then
+		Fact.Count = 2;
+}
+
+rule Third "third" {
+	when
+		Fact.Count == 2
+	then
+		Fact.Count = 3;
+		Complete();
+}
+`
+
+type TerminalFact struct {
+	Count int
+	Trail string
+}
+
+func TestTerminalDebugSession(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("TerminalTest", "0.0.1", pkg.NewBytesResource([]byte(terminalGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("TerminalTest", "0.0.1")
+	assert.NoError(t, err)
+	fact := &TerminalFact{}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Fact", fact))
+
+	in := strings.NewReader(strings.Join([]string{
+		"help",
+		"s",
+		"b Third",
+		"w Fact.Count > 5",
+		"w Fact.Count >",
+		"l",
+		"c",
+		"facts",
+		"candidates",
+		"p Fact.Count + 1",
+		"p Fact.Nothing",
+		"memory",
+		"d Third",
+		"d Fact.Count>5",
+		"oops",
+		"a",
+	}, "\n"))
+	out := &bytes.Buffer{}
+	session := newTerminalDebugSession(in, out)
+	session.BreakOnStart()
+	result, err := engine.NewGruleEngine().Debug(context.Background(), dctx, kb, session)
+	assert.ErrorIs(t, err, engine.ErrDebugAborted)
+	assert.Equal(t, engine.StopAborted, result.StopReason)
+	assert.Equal(t, 2, fact.Count)
+
+	output := out.String()
+	assert.Contains(t, output, "paused before cycle 1, agenda group MAIN")
+	assert.Contains(t, output, "paused before executing First in cycle 1\n")
+	assert.Contains(t, output, "breakpoint on rule Third")
+	assert.Contains(t, output, "breakpoint when Fact.Count>5")
+	assert.Contains(t, output, "error: invalid expression Fact.Count >")
+	assert.Contains(t, output, "paused before executing Third in cycle 3, breakpoint Third")
+	assert.Contains(t, output, "Fact = {Count:2 Trail:}")
+	assert.Contains(t, output, "1. Third salience 0")
+	assert.Contains(t, output, "Fact.Count + 1 = 3")
+	assert.Contains(t, output, "Fact.Count==2 = true")
+	assert.Contains(t, output, "breakpoint Third deleted")
+	assert.Contains(t, output, "breakpoint Fact.Count>5 deleted")
+	assert.Contains(t, output, "unknown command oops")
+}
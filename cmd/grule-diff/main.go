@@ -0,0 +1,94 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Command grule-diff compares two versions of a knowledge base and reports the added, removed
+// and modified rules. Each version is either a GRB file, as written by StoreKnowledgeBaseToWriter,
+// or a GRL file.
+//
+//	grule-diff old.grb new.grb
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s old.grb|old.grl new.grb|new.grl\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	diff, err := diffFiles(flag.Arg(0), flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	fmt.Print(diff.String())
+	if !diff.IsEmpty() {
+		os.Exit(1)
+	}
+}
+
+func diffFiles(oldPath, newPath string) (*ast.KnowledgeBaseDiff, error) {
+	oldKB, err := loadKnowledgeBase(oldPath)
+	if err != nil {
+
+		return nil, err
+	}
+	newKB, err := loadKnowledgeBase(newPath)
+	if err != nil {
+
+		return nil, err
+	}
+
+	return ast.DiffKnowledgeBases(oldKB, newKB), nil
+}
+
+func loadKnowledgeBase(path string) (*ast.KnowledgeBase, error) {
+	lib := ast.NewKnowledgeLibrary()
+	if strings.EqualFold(filepath.Ext(path), ".grl") {
+		err := builder.NewRuleBuilder(lib).BuildRuleFromResource("Diff", "0.0.1", pkg.NewFileResource(path))
+		if err != nil {
+
+			return nil, fmt.Errorf("error while building %s. got %w", path, err)
+		}
+
+		return lib.GetKnowledgeBase("Diff", "0.0.1"), nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+
+		return nil, err
+	}
+	defer file.Close()
+	knowledgeBase, err := lib.LoadKnowledgeBaseFromReader(file, true)
+	if err != nil {
+
+		return nil, fmt.Errorf("error while loading %s. got %w", path, err)
+	}
+
+	return knowledgeBase, nil
+}
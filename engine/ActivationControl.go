@@ -0,0 +1,118 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// newActivationControl creates the activation control for a new execution.
+func newActivationControl() *activationControl {
+
+	return &activationControl{
+		looped:           make(map[*ast.RuleEntry]bool),
+		locked:           make(map[*ast.RuleEntry]bool),
+		activationGroups: make(map[string]*ast.RuleEntry),
+	}
+}
+
+// activationControl keeps track of the executed rule entries during a single execution, so the engine can honor
+// the no-loop, lock-on-active and activation-group rule attributes.
+type activationControl struct {
+	// looped are the no-loop rule entries executed, whose when scope no other rule entry changed since.
+	looped map[*ast.RuleEntry]bool
+	// focus is the agenda group that had the focus when the locks were taken.
+	focus string
+	// locked are the lock-on-active rule entries executed since focus got the focus.
+	locked map[*ast.RuleEntry]bool
+	// activationGroups maps each activation group to the rule entry that was executed in it.
+	activationGroups map[string]*ast.RuleEntry
+}
+
+// SetFocus tells the agenda group that has the focus in this cycle. The lock-on-active locks are released
+// every time the focus goes to another agenda group.
+func (control *activationControl) SetFocus(focus string) {
+	if control.focus != focus {
+		control.focus = focus
+		control.locked = make(map[*ast.RuleEntry]bool)
+	}
+}
+
+// CanActivate will check if the rule entry can become a candidate in this cycle.
+func (control *activationControl) CanActivate(entry *ast.RuleEntry) bool {
+	if entry.NoLoop && control.looped[entry] {
+
+		return false
+	}
+	if entry.LockOnActive && control.locked[entry] {
+
+		return false
+	}
+	if len(entry.ActivationGroup) > 0 {
+		if executed, ok := control.activationGroups[entry.ActivationGroup]; ok && executed != entry {
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// Executed records that the rule entry has been executed. The no-loop rule entries whose when scope it may change
+// can be activated again, while it can't be activated again by its own changes if it's a no-loop rule entry.
+func (control *activationControl) Executed(entry *ast.RuleEntry) {
+	for looped := range control.looped {
+		if looped != entry && entry.MayActivate(looped) {
+			delete(control.looped, looped)
+		}
+	}
+	if entry.NoLoop {
+		control.looped[entry] = true
+	}
+	if entry.LockOnActive {
+		control.locked[entry] = true
+	}
+	if len(entry.ActivationGroup) > 0 {
+		if _, ok := control.activationGroups[entry.ActivationGroup]; !ok {
+			control.activationGroups[entry.ActivationGroup] = entry
+		}
+	}
+}
+
+// writeState writes the no-loop, lock-on-active and activation-group state to the writer, in a stable order.
+func (control *activationControl) writeState(writer io.Writer) {
+	names := func(entries map[*ast.RuleEntry]bool) []string {
+		sorted := make([]string, 0, len(entries))
+		for entry := range entries {
+			sorted = append(sorted, entry.RuleName)
+		}
+		sort.Strings(sorted)
+
+		return sorted
+	}
+	_, _ = fmt.Fprintf(writer, "looped=%v;locked=%s:%v;", names(control.looped), control.focus, names(control.locked))
+	groups := make([]string, 0, len(control.activationGroups))
+	for group := range control.activationGroups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		_, _ = fmt.Fprintf(writer, "group=%s:%s;", group, control.activationGroups[group].RuleName)
+	}
+}
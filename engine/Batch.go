@@ -0,0 +1,110 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// BatchResult is the outcome of the execution of one of the data contexts given to ExecuteBatch.
+type BatchResult struct {
+	// Result is nil if the data context wasn't executed, eg. no knowledge base instance could be obtained for its
+	// execution or the batch's context was done before it started.
+	Result *ExecutionResult
+	Err    error
+}
+
+// ExecuteBatch executes each data context with an instance of the pool's knowledge base, across at most
+// BatchWorkers goroutines. The instances are given back to the pool after each execution.
+// The results are in the same order as the data contexts. The engine's listeners, conflict resolver and functions
+// are shared by the executions, so they must be safe for concurrent use.
+// The data contexts fail on their own, the error returned is about the whole batch: once the context is done, the
+// data contexts not started yet are not executed, their result holds the context's error and so does the error
+// returned along with the results.
+func (g *GruleEngine) ExecuteBatch(ctx context.Context, pool *ast.KnowledgeBasePool, facts []ast.IDataContext) ([]BatchResult, error) {
+	if pool == nil {
+
+		return nil, fmt.Errorf("nil KnowledgeBasePool is not allowed")
+	}
+	results := make([]BatchResult, len(facts))
+	workers := g.batchWorkers()
+	if workers > len(facts) {
+		workers = len(facts)
+	}
+
+	next := int64(-1)
+	skipped := int32(0)
+	var group sync.WaitGroup
+	group.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer group.Done()
+			for {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= len(facts) {
+
+					return
+				}
+				if ctx.Err() != nil {
+					atomic.StoreInt32(&skipped, 1)
+					results[index] = BatchResult{Err: fmt.Errorf("data context not executed. got %w", ctx.Err())}
+
+					continue
+				}
+				results[index] = g.executePooled(ctx, pool, facts[index])
+			}
+		}()
+	}
+	group.Wait()
+	if atomic.LoadInt32(&skipped) == 1 {
+
+		return results, fmt.Errorf("batch interrupted before every data context was executed. got %w", ctx.Err())
+	}
+
+	return results, nil
+}
+
+// executePooled executes the data context with an instance taken from the pool.
+func (g *GruleEngine) executePooled(ctx context.Context, pool *ast.KnowledgeBasePool, dataCtx ast.IDataContext) BatchResult {
+	if dataCtx == nil {
+
+		return BatchResult{Err: fmt.Errorf("nil data context is not allowed")}
+	}
+	knowledge, err := pool.Get()
+	if err != nil {
+
+		return BatchResult{Err: err}
+	}
+	defer pool.Put(knowledge)
+	result, err := g.ExecuteWithResult(ctx, dataCtx, knowledge)
+
+	return BatchResult{Result: result, Err: err}
+}
+
+// batchWorkers returns the number of goroutines executing a batch.
+func (g *GruleEngine) batchWorkers() int {
+	if g.BatchWorkers < 1 {
+
+		return runtime.GOMAXPROCS(0)
+	}
+
+	return g.BatchWorkers
+}
@@ -0,0 +1,215 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// Activation is a rule entry that is a candidate for execution in the current cycle.
+type Activation struct {
+	RuleEntry *ast.RuleEntry
+
+	// Cycle is the cycle number when the rule entry became a candidate.
+	Cycle uint64
+	// Sequence is a number given to each new activation within an execution, it always increase.
+	Sequence uint64
+	// Order is the position of the rule entry in the evaluation order.
+	Order int
+}
+
+// ConflictResolver decides which rule entry to execute when there are more than one candidate in a cycle.
+type ConflictResolver interface {
+	// Sort will sort the activations, the first activation is the one to be executed.
+	Sort(activations []*Activation)
+}
+
+// SalienceConflictResolver picks the activation with the highest salience.
+// If there are more than one activation with the same salience, the one evaluated first wins.
+// This is the default strategy.
+type SalienceConflictResolver struct{}
+
+// Sort will sort the activations by their salience.
+func (r *SalienceConflictResolver) Sort(activations []*Activation) {
+	sort.SliceStable(activations, func(i, j int) bool {
+
+		return compareSalience(activations[i], activations[j]) < 0
+	})
+}
+
+// RecencyConflictResolver picks the activation that became a candidate most recently.
+// Ties are resolved by salience and then by evaluation order.
+type RecencyConflictResolver struct{}
+
+// Sort will sort the activations by their recency.
+func (r *RecencyConflictResolver) Sort(activations []*Activation) {
+	sort.SliceStable(activations, func(i, j int) bool {
+		if activations[i].Cycle != activations[j].Cycle {
+
+			return activations[i].Cycle > activations[j].Cycle
+		}
+
+		return compareSalience(activations[i], activations[j]) < 0
+	})
+}
+
+// SpecificityConflictResolver picks the activation whose rule entry have the most complex when scope.
+// Ties are resolved by salience and then by evaluation order.
+type SpecificityConflictResolver struct{}
+
+// Sort will sort the activations by the complexity of their when scope.
+func (r *SpecificityConflictResolver) Sort(activations []*Activation) {
+	sort.SliceStable(activations, func(i, j int) bool {
+		ci := activations[i].RuleEntry.Complexity()
+		cj := activations[j].RuleEntry.Complexity()
+		if ci != cj {
+
+			return ci > cj
+		}
+
+		return compareSalience(activations[i], activations[j]) < 0
+	})
+}
+
+// LIFOConflictResolver treats the candidates as a stack, the last activation is executed first.
+// The salience is not considered.
+type LIFOConflictResolver struct{}
+
+// Sort will sort the activations from the newest to the oldest.
+func (r *LIFOConflictResolver) Sort(activations []*Activation) {
+	sort.SliceStable(activations, func(i, j int) bool {
+
+		return activations[i].Sequence > activations[j].Sequence
+	})
+}
+
+// NewRandomConflictResolver create new instance of RandomConflictResolver.
+// Two resolvers created with the same seed will make the same choices given the same activations.
+func NewRandomConflictResolver(seed int64) *RandomConflictResolver {
+
+	return &RandomConflictResolver{
+		random: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// RandomConflictResolver picks a random activation using a seeded random source.
+type RandomConflictResolver struct {
+	lock   sync.Mutex
+	random *rand.Rand
+}
+
+// Sort will shuffle the activations.
+func (r *RandomConflictResolver) Sort(activations []*Activation) {
+	sort.SliceStable(activations, func(i, j int) bool {
+
+		return activations[i].Order < activations[j].Order
+	})
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.random.Shuffle(len(activations), func(i, j int) {
+		activations[i], activations[j] = activations[j], activations[i]
+	})
+}
+
+// compareSalience returns a negative number if activation a should be executed before b,
+// a positive number if b should be executed before a.
+func compareSalience(a, b *Activation) int {
+	switch {
+	case a.RuleEntry.Salience > b.RuleEntry.Salience:
+
+		return -1
+	case a.RuleEntry.Salience < b.RuleEntry.Salience:
+
+		return 1
+	}
+
+	return a.Order - b.Order
+}
+
+// ConflictExplainer can be implemented by a ConflictResolver to explain its choices in an ExecutionTrace.
+type ConflictExplainer interface {
+	// Explain tells why the first of the sorted activations is executed.
+	Explain(activations []*Activation) string
+}
+
+// explainConflict tells why the first of the sorted activations won the conflict resolution.
+func explainConflict(resolver ConflictResolver, activations []*Activation) string {
+	if len(activations) == 1 {
+
+		return "only candidate"
+	}
+	if explainer, ok := resolver.(ConflictExplainer); ok {
+
+		return explainer.Explain(activations)
+	}
+
+	return fmt.Sprintf("first candidate sorted by %T", resolver)
+}
+
+// explainSalience tells why activation a is executed before b when they are compared by salience.
+func explainSalience(a, b *Activation) string {
+	if a.RuleEntry.Salience != b.RuleEntry.Salience {
+
+		return fmt.Sprintf("highest salience %d, next candidate %s has salience %d", a.RuleEntry.Salience, b.RuleEntry.RuleName, b.RuleEntry.Salience)
+	}
+
+	return fmt.Sprintf("same salience %d as %s, evaluated first", a.RuleEntry.Salience, b.RuleEntry.RuleName)
+}
+
+// Explain implements ConflictExplainer
+func (r *SalienceConflictResolver) Explain(activations []*Activation) string {
+
+	return explainSalience(activations[0], activations[1])
+}
+
+// Explain implements ConflictExplainer
+func (r *RecencyConflictResolver) Explain(activations []*Activation) string {
+	a, b := activations[0], activations[1]
+	if a.Cycle != b.Cycle {
+
+		return fmt.Sprintf("most recent candidate since cycle %d, next candidate %s is a candidate since cycle %d", a.Cycle, b.RuleEntry.RuleName, b.Cycle)
+	}
+
+	return fmt.Sprintf("candidate since cycle %d like %s, %s", a.Cycle, b.RuleEntry.RuleName, explainSalience(a, b))
+}
+
+// Explain implements ConflictExplainer
+func (r *SpecificityConflictResolver) Explain(activations []*Activation) string {
+	a, b := activations[0], activations[1]
+	ca, cb := a.RuleEntry.Complexity(), b.RuleEntry.Complexity()
+	if ca != cb {
+
+		return fmt.Sprintf("most specific when scope with complexity %d, next candidate %s has complexity %d", ca, b.RuleEntry.RuleName, cb)
+	}
+
+	return fmt.Sprintf("same complexity %d as %s, %s", ca, b.RuleEntry.RuleName, explainSalience(a, b))
+}
+
+// Explain implements ConflictExplainer
+func (r *LIFOConflictResolver) Explain(activations []*Activation) string {
+
+	return fmt.Sprintf("last activation with sequence %d, next candidate %s has sequence %d", activations[0].Sequence, activations[1].RuleEntry.RuleName, activations[1].Sequence)
+}
+
+// Explain implements ConflictExplainer
+func (r *RandomConflictResolver) Explain(activations []*Activation) string {
+
+	return fmt.Sprintf("picked at random among %d candidates", len(activations))
+}
@@ -0,0 +1,157 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type ConflictFact struct {
+	Stage  int
+	Fired  string
+	Winner string
+}
+
+const conflictRules = `
+rule RuleB "B" salience 10 {
+	when
+		Fact.Winner == ""
+	then
+		Fact.Winner = "RuleB";
+		Complete();
+}
+
+rule RuleA "A" salience 10 {
+	when
+		Fact.Winner == ""
+	then
+		Fact.Winner = "RuleA";
+		Complete();
+}
+
+rule RuleC "C" salience 5 {
+	when
+		Fact.Winner == "" && Fact.Stage == 0 && Fact.Fired == ""
+	then
+		Fact.Winner = "RuleC";
+		Complete();
+}
+`
+
+const recencyRules = `
+rule Start "start" salience 100 {
+	when
+		Fact.Stage == 0
+	then
+		Fact.Stage = 1;
+}
+
+rule Old "candidate since the first cycle" salience 10 {
+	when
+		Fact.Winner == ""
+	then
+		Fact.Winner = "Old";
+		Complete();
+}
+
+rule New "candidate since the second cycle" {
+	when
+		Fact.Winner == "" && Fact.Stage == 1
+	then
+		Fact.Winner = "New";
+		Complete();
+}
+`
+
+func executeWithResolver(t *testing.T, grl string, resolver ConflictResolver) *ConflictFact {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ConflictTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ConflictTest", "0.0.1")
+	assert.NoError(t, err)
+
+	fact := &ConflictFact{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	eng := NewGruleEngine()
+	eng.ConflictResolver = resolver
+	err = eng.Execute(dctx, kb)
+	assert.NoError(t, err)
+
+	return fact
+}
+
+func TestSalienceConflictResolver(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, "RuleB", executeWithResolver(t, conflictRules, &SalienceConflictResolver{}).Winner)
+	}
+	assert.Equal(t, "Old", executeWithResolver(t, recencyRules, nil).Winner)
+}
+
+func TestSpecificityConflictResolver(t *testing.T) {
+	assert.Equal(t, "RuleC", executeWithResolver(t, conflictRules, &SpecificityConflictResolver{}).Winner)
+}
+
+func TestLIFOConflictResolver(t *testing.T) {
+	assert.Equal(t, "RuleC", executeWithResolver(t, conflictRules, &LIFOConflictResolver{}).Winner)
+	// Old is activated after Start in the first cycle, so it is executed before Start.
+	assert.Equal(t, "Old", executeWithResolver(t, recencyRules, &LIFOConflictResolver{}).Winner)
+}
+
+func TestRecencyConflictResolver(t *testing.T) {
+	assert.Equal(t, "New", executeWithResolver(t, recencyRules, &RecencyConflictResolver{}).Winner)
+}
+
+func TestRandomConflictResolver(t *testing.T) {
+	winner := executeWithResolver(t, conflictRules, NewRandomConflictResolver(42)).Winner
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, winner, executeWithResolver(t, conflictRules, NewRandomConflictResolver(42)).Winner)
+	}
+}
+
+func TestFetchMatchingRulesUsesConflictResolver(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ConflictTest", "0.0.1", pkg.NewBytesResource([]byte(conflictRules)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ConflictTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", &ConflictFact{})
+	assert.NoError(t, err)
+
+	eng := NewGruleEngine()
+	matching, err := eng.FetchMatchingRules(dctx, kb)
+	assert.NoError(t, err)
+	assert.Len(t, matching, 3)
+	assert.Equal(t, "RuleB", matching[0].RuleName)
+	assert.Equal(t, "RuleA", matching[1].RuleName)
+	assert.Equal(t, "RuleC", matching[2].RuleName)
+
+	eng.ConflictResolver = &SpecificityConflictResolver{}
+	matching, err = eng.FetchMatchingRules(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, "RuleC", matching[0].RuleName)
+}
@@ -0,0 +1,240 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// ErrDebugAborted is returned by GruleEngine.Debug when the debug session aborted the execution.
+var ErrDebugAborted = errors.New("execution aborted by the debug session")
+
+// DebugPoint tells where the execution paused.
+type DebugPoint string
+
+const (
+	// DebugBeforeCycle is the pause before the rule entries of the focused agenda group are evaluated.
+	DebugBeforeCycle DebugPoint = "before-cycle"
+	// DebugBeforeExecute is the pause before the then scope of the selected rule entry is executed.
+	DebugBeforeExecute DebugPoint = "before-execute"
+)
+
+// DebugCommand tells the engine how to go on after a pause.
+type DebugCommand int
+
+const (
+	// DebugContinue runs the execution until the next breakpoint.
+	DebugContinue DebugCommand = iota
+	// DebugStep runs the execution until the next pause point.
+	DebugStep
+	// DebugAbort stops the execution, GruleEngine.Debug returns ErrDebugAborted.
+	DebugAbort
+)
+
+// DebugHandler is called each time the execution pauses, it returns how the execution goes on.
+type DebugHandler func(pause *DebugPause) DebugCommand
+
+// DebugPause describes the state of the execution when it paused.
+type DebugPause struct {
+	Point       DebugPoint
+	Cycle       uint64
+	AgendaGroup string
+	// Breakpoint is the breakpoint that paused the execution, it's empty when stepping.
+	Breakpoint string
+	// Candidates are the candidate rule entries sorted by the conflict resolver, they are only known before execute.
+	Candidates []*Activation
+	// Rule is the rule entry about to be executed, it's only known before execute.
+	Rule *ast.RuleEntry
+
+	DataContext ast.IDataContext
+	Knowledge   *ast.KnowledgeBase
+}
+
+// Facts returns the facts of the data context, by their name.
+func (p *DebugPause) Facts() map[string]interface{} {
+	facts := make(map[string]interface{})
+	for _, key := range p.DataContext.GetKeys() {
+		if key == "DEFUNC" || p.DataContext.IsRetracted(key) {
+			continue
+		}
+		value := p.DataContext.Get(key).Value()
+		if value.IsValid() && value.CanInterface() {
+			facts[key] = value.Interface()
+		}
+	}
+
+	return facts
+}
+
+// CachedValues returns the values of the expressions cached by the working memory, by their GRL text.
+func (p *DebugPause) CachedValues() map[string]reflect.Value {
+
+	return p.Knowledge.WorkingMemory.EvaluatedExpressions()
+}
+
+// Evaluate evaluates the when scope of the rule entry of an already built knowledge base against the data context,
+// eg. the knowledge base built from `rule Amount { when Fact.Amount * 2 then Complete(); }`.
+// The working memory of the execution is not used, the expression is always evaluated with the current facts.
+func (p *DebugPause) Evaluate(expressions *ast.KnowledgeBase, ruleName string) (reflect.Value, error) {
+	expression, err := newDebugExpression(expressions, ruleName)
+	if err != nil {
+
+		return reflect.Value{}, err
+	}
+
+	return expression.evaluate(p.DataContext)
+}
+
+// debugExpression is the when scope of a rule entry, evaluated outside of the execution of its knowledge base.
+type debugExpression struct {
+	text       string
+	knowledge  *ast.KnowledgeBase
+	expression *ast.Expression
+}
+
+// newDebugExpression takes the when scope of the rule entry of the knowledge base.
+func newDebugExpression(knowledge *ast.KnowledgeBase, ruleName string) (*debugExpression, error) {
+	if knowledge == nil {
+
+		return nil, fmt.Errorf("nil KnowledgeBase is not allowed")
+	}
+	ruleEntry, ok := knowledge.RuleEntries[ruleName]
+	if !ok || ruleEntry.WhenScope == nil || ruleEntry.WhenScope.Expression == nil {
+
+		return nil, fmt.Errorf("rule entry %s with a when scope not found in knowledge base %s:%s", ruleName, knowledge.Name, knowledge.Version)
+	}
+
+	return &debugExpression{
+		text:       ruleEntry.WhenScope.Expression.GetGrlText(),
+		knowledge:  knowledge,
+		expression: ruleEntry.WhenScope.Expression,
+	}, nil
+}
+
+// evaluate evaluates the expression with the current facts of the data context, in a session of the knowledge
+// base's working memory so the knowledge base can be the one being executed.
+func (d *debugExpression) evaluate(dataCtx ast.IDataContext) (reflect.Value, error) {
+
+	return d.expression.Evaluate(dataCtx, d.knowledge.WorkingMemory.NewSession())
+}
+
+// NewDebugSession creates a debug session whose handler is called each time the execution pauses.
+func NewDebugSession(handler DebugHandler) *DebugSession {
+
+	return &DebugSession{
+		Handler:         handler,
+		ruleBreakpoints: make(map[string]bool),
+		conditions:      make([]*debugExpression, 0),
+	}
+}
+
+// DebugSession pauses an execution made by GruleEngine.Debug at its breakpoints, or at each pause point when stepping.
+// The execution pauses before each cycle, where the condition breakpoints are checked, and before each then scope
+// execution, where the rule breakpoints are checked.
+// The breakpoints can be changed by the handler during a pause, but a session is not safe for concurrent use.
+type DebugSession struct {
+	Handler DebugHandler
+
+	ruleBreakpoints map[string]bool
+	conditions      []*debugExpression
+	stepping        bool
+}
+
+// BreakOnStart makes the execution pause at its first pause point.
+func (s *DebugSession) BreakOnStart() {
+	s.stepping = true
+}
+
+// BreakOnRule makes the execution pause before the rule entry is executed.
+func (s *DebugSession) BreakOnRule(ruleName string) {
+	s.ruleBreakpoints[ruleName] = true
+}
+
+// BreakWhen makes the execution pause before a cycle if the when scope of the rule entry of an already built
+// knowledge base is true, eg. the knowledge base built from `rule Big { when Fact.Amount > 100 then Complete(); }`.
+// The breakpoint is named by the GRL text of the when scope.
+func (s *DebugSession) BreakWhen(conditions *ast.KnowledgeBase, ruleName string) error {
+	compiled, err := newDebugExpression(conditions, ruleName)
+	if err != nil {
+
+		return err
+	}
+	s.conditions = append(s.conditions, compiled)
+
+	return nil
+}
+
+// RemoveBreakpoint removes the rule breakpoint or the condition breakpoint, it returns false if there is no such breakpoint.
+func (s *DebugSession) RemoveBreakpoint(ruleNameOrCondition string) bool {
+	if s.ruleBreakpoints[ruleNameOrCondition] {
+		delete(s.ruleBreakpoints, ruleNameOrCondition)
+
+		return true
+	}
+	for i, condition := range s.conditions {
+		if condition.text == ruleNameOrCondition {
+			s.conditions = append(s.conditions[:i], s.conditions[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Breakpoints returns the sorted rule breakpoints, followed by the condition breakpoints in the order they were added.
+func (s *DebugSession) Breakpoints() []string {
+	breakpoints := make([]string, 0, len(s.ruleBreakpoints)+len(s.conditions))
+	for ruleName := range s.ruleBreakpoints {
+		breakpoints = append(breakpoints, ruleName)
+	}
+	sort.Strings(breakpoints)
+	for _, condition := range s.conditions {
+		breakpoints = append(breakpoints, condition.text)
+	}
+
+	return breakpoints
+}
+
+// pause calls the handler if the execution is stepping or hit a breakpoint.
+func (s *DebugSession) pause(pause *DebugPause) DebugCommand {
+	switch {
+	case pause.Point == DebugBeforeExecute && s.ruleBreakpoints[pause.Rule.RuleName]:
+		pause.Breakpoint = pause.Rule.RuleName
+	case pause.Point == DebugBeforeCycle:
+		for _, condition := range s.conditions {
+			// a condition that can't be evaluated, eg. because a fact is missing, is not hit.
+			value, err := condition.evaluate(pause.DataContext)
+			if err == nil && value.Kind() == reflect.Bool && value.Bool() {
+				pause.Breakpoint = condition.text
+
+				break
+			}
+		}
+	}
+	if !s.stepping && len(pause.Breakpoint) == 0 {
+
+		return DebugContinue
+	}
+	command := s.Handler(pause)
+	s.stepping = command == DebugStep
+
+	return command
+}
@@ -0,0 +1,111 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// executionObserver receives the events of the AST nodes during an execution, it records them into the
+// traced cycle and forwards them to the extended listeners.
+type executionObserver struct {
+	ctx       context.Context
+	listeners []GruleEngineExtendedListener
+
+	// cycle and entry are the current cycle and the rule entry being evaluated or executed.
+	cycle uint64
+	entry *ast.RuleEntry
+	// trace is the traced cycle, it's nil when the execution is not traced.
+	trace *CycleTrace
+	// loops records the assigned variables, it's nil when the loops are not detected.
+	loops *loopDetector
+}
+
+// newExecutionObserver creates the observer of an execution, it returns nil if
+// there is no trace to make, no loop to detect nor extended listener to notify.
+func newExecutionObserver(ctx context.Context, listeners []GruleEngineListener, trace *ExecutionTrace, loops *loopDetector) *executionObserver {
+	extended := make([]GruleEngineExtendedListener, 0)
+	for _, listener := range listeners {
+		if extendedListener, ok := listener.(GruleEngineExtendedListener); ok {
+			extended = append(extended, extendedListener)
+		}
+	}
+	if trace == nil && loops == nil && len(extended) == 0 {
+
+		return nil
+	}
+
+	return &executionObserver{
+		ctx:       ctx,
+		listeners: extended,
+		loops:     loops,
+	}
+}
+
+// VariableAssigned implements ast.ExecutionObserver
+func (o *executionObserver) VariableAssigned(variable string, oldValue, newValue reflect.Value) {
+	if o.trace != nil {
+		o.trace.addAssignment(variable, oldValue, newValue)
+	}
+	if o.loops != nil {
+		o.loops.variableAssigned(variable)
+	}
+	for _, listener := range o.listeners {
+		listener.VariableAssigned(o.ctx, o.cycle, o.entry, variable, oldValue, newValue)
+	}
+}
+
+// FunctionCalled implements ast.ExecutionObserver
+func (o *executionObserver) FunctionCalled(function string, args []reflect.Value, result reflect.Value, err error, duration time.Duration) {
+	if len(o.listeners) == 0 {
+
+		return
+	}
+	call := &FunctionCall{
+		Name:     function,
+		Args:     args,
+		Result:   result,
+		Err:      err,
+		Duration: duration,
+	}
+	for _, listener := range o.listeners {
+		listener.FunctionCalled(o.ctx, o.cycle, o.entry, call)
+	}
+}
+
+// RuleRetracted implements ast.ExecutionObserver
+func (o *executionObserver) RuleRetracted(ruleName string) {
+	for _, listener := range o.listeners {
+		listener.RuleRetracted(o.ctx, o.cycle, o.entry, ruleName)
+	}
+}
+
+// ruleEntryFailed notifies the extended listeners that a rule entry failed to be evaluated or executed.
+func (o *executionObserver) ruleEntryFailed(entry *ast.RuleEntry, err error) {
+	for _, listener := range o.listeners {
+		listener.RuleEntryFailed(o.ctx, o.cycle, entry, err)
+	}
+}
+
+// executionFinished notifies the extended listeners that the execution is over.
+func (o *executionObserver) executionFinished(result *ExecutionResult) {
+	for _, listener := range o.listeners {
+		listener.ExecutionFinished(o.ctx, result)
+	}
+}
@@ -0,0 +1,85 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"time"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// StopReason tells why an execution stopped.
+type StopReason string
+
+const (
+	// StopNoMoreRule means there was no more rule entry to execute.
+	StopNoMoreRule StopReason = "no-more-rule"
+	// StopCompleted means a rule called Complete().
+	StopCompleted StopReason = "completed"
+	// StopMaxCycle means the execution reached the engine's MaxCycle.
+	StopMaxCycle StopReason = "max-cycle"
+	// StopCanceled means the execution context was canceled.
+	StopCanceled StopReason = "canceled"
+	// StopError means a rule entry failed to be evaluated or executed.
+	StopError StopReason = "error"
+	// StopAborted means a debug session aborted the execution.
+	StopAborted StopReason = "aborted"
+	// StopLoop means the execution was looping, see GruleEngine.DetectLoops.
+	StopLoop StopReason = "loop"
+)
+
+// ExecutionResult describes a finished execution.
+type ExecutionResult struct {
+	KnowledgeBase string
+	Version       string
+	Cycles        uint64
+	Duration      time.Duration
+	StopReason    StopReason
+	// Err is the error returned by the execution, if any.
+	Err error
+	// RolledBack tells the changes made to the facts were reverted because the execution failed,
+	// see GruleEngine.RollbackOnFailure.
+	RolledBack bool
+	// FiredRules are the executed rule entries, in execution order.
+	FiredRules []FiredRule
+	// FireCounts is the number of times each rule entry was executed, by rule name.
+	FireCounts map[string]int
+}
+
+// FiredRule is a rule entry executed in a cycle.
+type FiredRule struct {
+	RuleName string
+	Cycle    uint64
+}
+
+// newExecutionResult creates the result of an execution of the knowledge base.
+func newExecutionResult(knowledge *ast.KnowledgeBase) *ExecutionResult {
+
+	return &ExecutionResult{
+		KnowledgeBase: knowledge.Name,
+		Version:       knowledge.Version,
+		FiredRules:    make([]FiredRule, 0),
+		FireCounts:    make(map[string]int),
+	}
+}
+
+// fired records the execution of the rule entry.
+func (r *ExecutionResult) fired(entry *ast.RuleEntry, cycle uint64) {
+	r.FiredRules = append(r.FiredRules, FiredRule{
+		RuleName: entry.RuleName,
+		Cycle:    cycle,
+	})
+	r.FireCounts[entry.RuleName]++
+}
@@ -0,0 +1,177 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// ExecutionTrace explains an execution made by GruleEngine.ExecuteWithTrace.
+// It can be serialized to JSON.
+type ExecutionTrace struct {
+	KnowledgeBase string        `json:"knowledgeBase"`
+	Version       string        `json:"version"`
+	Cycles        []*CycleTrace `json:"cycles"`
+}
+
+// CycleTrace explains a single cycle, which rule entries were candidates and what the executed one did.
+type CycleTrace struct {
+	Cycle       uint64 `json:"cycle"`
+	AgendaGroup string `json:"agendaGroup"`
+	// Candidates are the candidate rule entries, in the order decided by the conflict resolver.
+	Candidates []*CandidateTrace `json:"candidates"`
+	// Fired is the name of the executed rule entry.
+	Fired string `json:"fired"`
+	// Reason tells why the executed rule entry won the conflict resolution.
+	Reason string `json:"reason"`
+	// Conditions are the sub-expressions of the executed rule entry's when scope, from the outermost one.
+	Conditions []*ExpressionTrace `json:"conditions"`
+	// Assignments are the assignments made by the executed rule entry's then scope, in execution order.
+	Assignments []*AssignmentTrace `json:"assignments"`
+}
+
+// CandidateTrace describes a candidate rule entry of a cycle.
+type CandidateTrace struct {
+	RuleName   string `json:"ruleName"`
+	Salience   int    `json:"salience"`
+	Complexity int    `json:"complexity"`
+	// Cycle is the cycle number when the rule entry became a candidate.
+	Cycle    uint64 `json:"cycle"`
+	Sequence uint64 `json:"sequence"`
+	Order    int    `json:"order"`
+}
+
+// ExpressionTrace holds the value of an expression when the rule entry was selected.
+// An expression that was not evaluated, eg. the right side of a short-circuited &&, has no value.
+type ExpressionTrace struct {
+	Expression string      `json:"expression"`
+	Evaluated  bool        `json:"evaluated"`
+	Value      interface{} `json:"value,omitempty"`
+}
+
+// AssignmentTrace holds the value of a variable before and after an assignment.
+type AssignmentTrace struct {
+	Variable string      `json:"variable"`
+	OldValue interface{} `json:"oldValue"`
+	NewValue interface{} `json:"newValue"`
+}
+
+// newExecutionTrace creates an empty trace of an execution of the knowledge base.
+func newExecutionTrace(knowledge *ast.KnowledgeBase) *ExecutionTrace {
+	trace := &ExecutionTrace{
+		Cycles: make([]*CycleTrace, 0),
+	}
+	if knowledge != nil {
+		trace.KnowledgeBase = knowledge.Name
+		trace.Version = knowledge.Version
+	}
+
+	return trace
+}
+
+// addCycle records the cycle where the first of the sorted activations is executed.
+func (t *ExecutionTrace) addCycle(cycle uint64, focus string, activations []*Activation, resolver ConflictResolver, memory *ast.WorkingMemory) *CycleTrace {
+	runner := activations[0].RuleEntry
+	cycleTrace := &CycleTrace{
+		Cycle:       cycle,
+		AgendaGroup: focus,
+		Candidates:  make([]*CandidateTrace, len(activations)),
+		Fired:       runner.RuleName,
+		Reason:      explainConflict(resolver, activations),
+		Conditions:  make([]*ExpressionTrace, 0),
+		Assignments: make([]*AssignmentTrace, 0),
+	}
+	for i, activation := range activations {
+		cycleTrace.Candidates[i] = &CandidateTrace{
+			RuleName:   activation.RuleEntry.RuleName,
+			Salience:   activation.RuleEntry.Salience,
+			Complexity: activation.RuleEntry.Complexity(),
+			Cycle:      activation.Cycle,
+			Sequence:   activation.Sequence,
+			Order:      activation.Order,
+		}
+	}
+	if runner.WhenScope != nil {
+		cycleTrace.traceExpression(runner.WhenScope.Expression, memory)
+	}
+	t.Cycles = append(t.Cycles, cycleTrace)
+
+	return cycleTrace
+}
+
+// traceExpression records the value of the expression and its sub-expressions, cached by the working memory.
+func (c *CycleTrace) traceExpression(expression *ast.Expression, memory *ast.WorkingMemory) {
+	if expression == nil {
+
+		return
+	}
+	value, evaluated := memory.EvaluatedValue(expression)
+	expressionTrace := &ExpressionTrace{
+		Expression: expression.GrlText,
+		Evaluated:  evaluated,
+	}
+	if evaluated {
+		expressionTrace.Value = traceValue(value)
+	}
+	c.Conditions = append(c.Conditions, expressionTrace)
+	c.traceExpression(expression.SingleExpression, memory)
+	c.traceExpression(expression.LeftExpression, memory)
+	c.traceExpression(expression.RightExpression, memory)
+}
+
+// addAssignment records an assignment made by the executed rule entry.
+func (c *CycleTrace) addAssignment(variable string, oldValue, newValue reflect.Value) {
+	c.Assignments = append(c.Assignments, &AssignmentTrace{
+		Variable: variable,
+		OldValue: traceValue(oldValue),
+		NewValue: traceValue(newValue),
+	})
+}
+
+// traceValue converts a value into something that can be serialized to JSON and won't change after the trace is made.
+func traceValue(value reflect.Value) interface{} {
+	if !value.IsValid() || !value.CanInterface() {
+
+		return nil
+	}
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+
+		return value.Interface()
+	case reflect.Float32, reflect.Float64:
+		// JSON has no NaN nor infinity.
+		if math.IsNaN(value.Float()) || math.IsInf(value.Float(), 0) {
+
+			return fmt.Sprintf("%v", value.Interface())
+		}
+
+		return value.Interface()
+	}
+	// other values may be changed by the next rules, take their JSON form now.
+	data, err := json.Marshal(value.Interface())
+	if err != nil {
+
+		return fmt.Sprintf("%v", value.Interface())
+	}
+
+	return json.RawMessage(data)
+}
@@ -20,11 +20,12 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/sirupsen/logrus"
 	"go.uber.org/zap"
-	"sort"
+	"sync"
 	"time"
 
 	"github.com/hyperjumptech/grule-rule-engine/ast"
 	"github.com/hyperjumptech/grule-rule-engine/logger"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
 )
 
 const (
@@ -79,7 +80,9 @@ func SetLogger(externalLog interface{}) {
 func NewGruleEngine() *GruleEngine {
 
 	return &GruleEngine{
-		MaxCycle: DefaultCycleCount,
+		MaxCycle:         DefaultCycleCount,
+		ConflictResolver: &SalienceConflictResolver{},
+		Clock:            &pkg.SystemClock{},
 	}
 }
 
@@ -88,6 +91,105 @@ type GruleEngine struct {
 	MaxCycle                        uint64
 	ReturnErrOnFailedRuleEvaluation bool
 	Listeners                       []GruleEngineListener
+	// ConflictResolver decides which candidate rule to execute in a cycle.
+	// If it's nil, the SalienceConflictResolver will be used.
+	ConflictResolver ConflictResolver
+	// Clock tells the time used to check the rules' date-effective and date-expires window,
+	// and the time returned by the Now() function in GRL. If it's nil, the system clock will be used.
+	Clock pkg.Clock
+	// Functions are custom functions the rules can call, they take precedence over the functions registered
+	// into the knowledge library by the same name, eg. to replace them in tests.
+	Functions *ast.FunctionRegistry
+	// EvaluationWorkers is the number of goroutines evaluating the when scopes of the rule entries in each cycle.
+	// With 0 or 1, the rule entries are evaluated one after another. Evaluating them concurrently can only pay off
+	// with several cores and many rule entries with costly when scopes, measure it before setting it. The functions
+	// and the facts' methods the when scopes call must then be safe for concurrent use. The candidates and the executed rule entries stay the same.
+	// The rule entries are evaluated one after another anyway while tracing the execution, detecting the loops
+	// or with a GruleEngineExtendedListener, as their events tell which rule entry is being evaluated.
+	EvaluationWorkers int
+	// BatchWorkers is the number of goroutines executing the data contexts given to ExecuteBatch.
+	// With 0, it's the number of CPUs Go can use.
+	BatchWorkers int
+	// RollbackOnFailure makes the executions journal the changes made to the facts by the assignments, the Append
+	// function and the facts added by the rules, and revert them if the execution fails or its context is canceled.
+	// The changes a fact's own function makes are not reverted.
+	RollbackOnFailure bool
+	// DetectLoops makes the executions stop with a LoopError as soon as they get back to the state they were in
+	// after an earlier cycle, with the same rule entry executed: the rule entries executed since then would be
+	// executed again and again until MaxCycle is reached. The state is made of the facts, the agenda group having
+	// the focus and the retracted rule entries, so the functions the rule entries call must not depend on anything else.
+	// The facts are hashed after each cycle, including the unexported fields of the structs.
+	DetectLoops bool
+	// UseReteNetwork makes the executions match the rule entries through the RETE network of the knowledge base, see
+	// ast.ReteNetwork. A rule entry's when scope is only evaluated again once a change of the facts propagates to it,
+	// the other rule entries keep their match and the listeners are not notified of their evaluation. The agenda is
+	// still built at each cycle from the rule entries that match. The candidates and the executed rule entries stay
+	// the same.
+	UseReteNetwork bool
+
+	// focus are the agenda groups set by SetFocus, shared by the executions that aren't given their own focus.
+	focus     []string
+	focusLock sync.RWMutex
+}
+
+// clock returns the clock used by this engine.
+func (g *GruleEngine) clock() pkg.Clock {
+	if g.Clock == nil {
+
+		return &pkg.SystemClock{}
+	}
+
+	return g.Clock
+}
+
+// evaluationWorkers returns the number of goroutines evaluating the when scopes in the execution,
+// or 0 if they must be evaluated one after another.
+func (g *GruleEngine) evaluationWorkers(exec *execution) int {
+	if g.EvaluationWorkers <= 1 || exec.observer != nil || exec.dryRun {
+
+		return 0
+	}
+
+	return g.EvaluationWorkers
+}
+
+// SetFocus will set the agenda groups that have the focus when an execution starts.
+// The first group will have the focus, when it has no more rule to execute the focus
+// goes to the next group, and finally to the ast.MainAgendaGroup.
+// Calling SetFocus without any group will start the execution from ast.MainAgendaGroup.
+// The focus is shared by all the executions of this engine, use ExecuteWithFocus to give it to a single execution.
+func (g *GruleEngine) SetFocus(groups ...string) {
+	g.focusLock.Lock()
+	defer g.focusLock.Unlock()
+	g.focus = append([]string{}, groups...)
+}
+
+// initialFocus returns a copy of the agenda groups set by SetFocus.
+func (g *GruleEngine) initialFocus() []string {
+	g.focusLock.RLock()
+	defer g.focusLock.RUnlock()
+
+	return append([]string{}, g.focus...)
+}
+
+// newAgenda creates the agenda for a new execution, the first of the specified groups has the focus.
+func (g *GruleEngine) newAgenda(focus []string) *ast.Agenda {
+	agenda := ast.NewAgenda()
+	for i := len(focus) - 1; i >= 0; i-- {
+		agenda.SetFocus(focus[i])
+	}
+
+	return agenda
+}
+
+// conflictResolver returns the conflict resolution strategy used by this engine.
+func (g *GruleEngine) conflictResolver() ConflictResolver {
+	if g.ConflictResolver == nil {
+
+		return &SalienceConflictResolver{}
+	}
+
+	return g.ConflictResolver
 }
 
 // Execute function is the same as ExecuteWithContext(context.Background())
@@ -123,13 +225,107 @@ func (g *GruleEngine) notifyBeginCycle(ctx context.Context, cycle uint64) {
 	}
 }
 
+// timingListeners returns the listeners that measure the executions.
+func (g *GruleEngine) timingListeners() []GruleEngineTimingListener {
+	timers := make([]GruleEngineTimingListener, 0)
+	for _, listener := range g.Listeners {
+		if timer, ok := listener.(GruleEngineTimingListener); ok {
+			timers = append(timers, timer)
+		}
+	}
+
+	return timers
+}
+
 // ExecuteWithContext function will execute a knowledge evaluation and action against data context.
 // The engine will evaluate context cancelation status in each cycle.
 // The engine also do conflict resolution of which rule to execute.
 func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) error {
+
+	_, err := g.execute(ctx, dataCtx, knowledge, &execution{})
+
+	return err
+}
+
+// ExecuteWithResult function is the same as ExecuteWithContext, but it also returns an ExecutionResult telling
+// the rule entries executed in order, how many times each of them was executed, the number of cycles,
+// why the execution stopped and how long it took.
+// The result is returned even if the execution failed, unless the knowledge base or the data context is nil.
+func (g *GruleEngine) ExecuteWithResult(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) (*ExecutionResult, error) {
+
+	return g.execute(ctx, dataCtx, knowledge, &execution{})
+}
+
+// ExecuteWithFocus function is the same as ExecuteWithResult, but the specified agenda groups have the focus when
+// the execution starts, as set by SetFocus, for this execution only. Without any group, the execution starts from
+// ast.MainAgendaGroup whatever the focus set by SetFocus.
+func (g *GruleEngine) ExecuteWithFocus(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, groups ...string) (*ExecutionResult, error) {
+
+	return g.execute(ctx, dataCtx, knowledge, &execution{focus: append([]string{}, groups...)})
+}
+
+// ExecuteWithTrace function is the same as ExecuteWithContext, but it also returns an ExecutionTrace explaining
+// which rule entries were candidates in each cycle, why the executed one won the conflict resolution,
+// the values of its when scope's expressions and the assignments made by its then scope.
+// The trace is returned even if the execution failed, it contains the cycles made until the failure.
+func (g *GruleEngine) ExecuteWithTrace(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) (*ExecutionTrace, error) {
+	trace := newExecutionTrace(knowledge)
+	_, err := g.execute(ctx, dataCtx, knowledge, &execution{trace: trace})
+
+	return trace, err
+}
+
+// Debug function is the same as ExecuteWithResult, but the debug session can pause the execution before each cycle
+// and before each rule entry execution, to inspect the candidates and the facts, and to step or abort the execution.
+func (g *GruleEngine) Debug(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, session *DebugSession) (*ExecutionResult, error) {
+	if session == nil || session.Handler == nil {
+
+		return nil, fmt.Errorf("nil DebugSession or DebugHandler is not allowed")
+	}
+
+	return g.execute(ctx, dataCtx, knowledge, &execution{debug: session})
+}
+
+// DryRun function is the same as ExecuteWithContext, but the facts of the data context are left untouched.
+// The rule entries work on a copy of each fact they change, the assignments, the Append calls and the facts they
+// insert, modify or delete are returned as a ChangeSet telling the changed variable, its old and new value and the rule entry that changed it.
+// A fact changed by one of its own functions is recorded as the replacement of the whole fact.
+// The ChangeSet can be applied to the data context afterward. It's returned even if the execution failed,
+// it contains the changes made until the failure.
+func (g *GruleEngine) DryRun(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) (*ast.ChangeSet, error) {
 	if knowledge == nil || dataCtx == nil {
 
-		return fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
+		return nil, fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
+	}
+	dryRunCtx := ast.NewDryRunDataContext(dataCtx)
+	_, err := g.execute(ctx, dryRunCtx, knowledge, &execution{dryRun: true})
+
+	return dryRunCtx.ChangeSet(), err
+}
+
+// execution holds what a single execution needs besides the engine loop's own variables.
+type execution struct {
+	// trace records each cycle when the execution is traced.
+	trace *ExecutionTrace
+	// debug pauses the execution when it's debugged.
+	debug *DebugSession
+	// focus are the agenda groups that have the focus when the execution starts.
+	focus []string
+	// dryRun tells the facts are copied by the data context when they're first changed, which isn't safe for concurrent use.
+	dryRun bool
+	// loops detects the loops, it is nil unless GruleEngine.DetectLoops is set.
+	loops *loopDetector
+
+	observer *executionObserver
+	timers   []GruleEngineTimingListener
+	result   *ExecutionResult
+}
+
+// execute runs the knowledge base against the data context, as set up by the execution.
+func (g *GruleEngine) execute(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, exec *execution) (*ExecutionResult, error) {
+	if knowledge == nil || dataCtx == nil {
+
+		return nil, fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
 	}
 
 	log.Debugf("Starting rule execution using knowledge '%s' version %s. Contains %d rule entries", knowledge.Name, knowledge.Version, len(knowledge.RuleEntries))
@@ -137,17 +333,75 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 	// Prepare the timer, we need to measure the processing time in debug mode.
 	startTime := time.Now()
 
+	// The changes made to the facts are journaled, to be reverted if the execution fails.
+	var transaction *ast.TransactionDataContext
+	if g.RollbackOnFailure && !exec.dryRun {
+		transaction = ast.NewTransactionDataContext(dataCtx)
+		dataCtx = transaction
+	}
+
+	// The AST nodes events are received by an observer set into the data context, only if someone needs them.
+	if g.DetectLoops {
+		exec.loops = newLoopDetector()
+	}
+	exec.observer = newExecutionObserver(ctx, g.Listeners, exec.trace, exec.loops)
+	if observable, ok := dataCtx.(ast.ObservableDataContext); ok && exec.observer != nil {
+		observable.SetObserver(exec.observer)
+		defer observable.SetObserver(nil)
+	}
+
+	if exec.focus == nil {
+		exec.focus = g.initialFocus()
+	}
+	exec.timers = g.timingListeners()
+	exec.result = newExecutionResult(knowledge)
+	cycle, reason, err := g.run(ctx, dataCtx, knowledge, exec)
+	result := exec.result
+	if transaction != nil && err != nil {
+		log.Debugf("Rolling back %d changes made to the facts", transaction.Changes())
+		result.RolledBack = true
+		if rollbackErr := transaction.Rollback(); rollbackErr != nil {
+			err = fmt.Errorf("%w. the rollback failed too, got %v", err, rollbackErr)
+		}
+	}
+	result.Cycles = cycle
+	result.Duration = time.Since(startTime)
+	result.StopReason = reason
+	result.Err = err
+	if err == nil {
+		log.Debugf("Finished Rules execution. With knowledge base '%s' version %s. Total #%d cycles. Duration %d ms.", knowledge.Name, knowledge.Version, cycle, result.Duration.Nanoseconds()/1e6)
+	}
+	if exec.observer != nil {
+		exec.observer.executionFinished(result)
+	}
+	for _, timer := range exec.timers {
+		timer.ExecutionEnded(ctx, result)
+	}
+
+	return result, err
+}
+
+// run is the execution loop, it returns the number of cycles made and why it stopped.
+// The executed rule entries are recorded into the execution's result.
+func (g *GruleEngine) run(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, exec *execution) (uint64, StopReason, error) {
+	// Prepare the agenda, only the rules in the agenda group that has the focus are evaluated.
+	agenda := g.newAgenda(exec.focus)
+
 	// Prepare the build-in function and add to datacontext.
 	defunc := &ast.BuiltInFunctions{
-		Knowledge:     knowledge,
-		WorkingMemory: knowledge.WorkingMemory,
-		DataContext:   dataCtx,
+		Knowledge:      knowledge,
+		WorkingMemory:  knowledge.WorkingMemory,
+		DataContext:    dataCtx,
+		Agenda:         agenda,
+		Clock:          g.clock(),
+		Functions:      g.Functions,
+		Justifications: ast.NewJustifications(),
 	}
 	err := dataCtx.Add("DEFUNC", defunc)
 	if err != nil {
 		log.Error("DEFUNC add err")
 
-		return err
+		return 0, StopError, err
 	}
 
 	// Working memory need to be resetted. all Expression will be set as not evaluated.
@@ -161,6 +415,124 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 
 	var cycle uint64
 
+	// Keep track of the activations, so the conflict resolver can tell which candidates are new.
+	resolver := g.conflictResolver()
+	// the network holds the ordered rule entries and the facts they refer to, it's only matched if it's used.
+	network := knowledge.ReteNetwork()
+	ruleEntries := network.RuleEntries()
+	var matcher *ast.ReteMatcher
+	if g.UseReteNetwork {
+		matcher = network.NewMatcher(knowledge.WorkingMemory)
+		defer matcher.Detach()
+	}
+	allOrders := make([]int, len(ruleEntries))
+	for order := range allOrders {
+		allOrders[order] = order
+	}
+	matching := make([]int, 0, len(ruleEntries))
+	activations := newActivationTable(len(ruleEntries))
+	timed := len(exec.timers) > 0
+	control := newActivationControl()
+
+	// evaluate evaluates the when scope of the rule entry of that order, or takes its concurrent evaluation if there
+	// are evaluations. The matcher, the timers, the trace and the listeners are told about every evaluation.
+	evaluate := func(order int, ruleEntry *ast.RuleEntry, evaluations []ruleEvaluation) (bool, error) {
+		if exec.observer != nil {
+			exec.observer.cycle = cycle + 1
+			exec.observer.entry = ruleEntry
+		}
+		var evaluation ruleEvaluation
+		if evaluations != nil {
+			evaluation = evaluations[order]
+		} else {
+			evaluation = evaluateRuleEntry(ctx, dataCtx, knowledge.WorkingMemory, ruleEntry, timed)
+		}
+		can, err := evaluation.can, evaluation.err
+		if matcher != nil {
+			matcher.Evaluated(order, can, err)
+		}
+		for _, timer := range exec.timers {
+			timer.RuleEntryEvaluated(ctx, knowledge, cycle+1, ruleEntry, can, err, evaluation.duration)
+		}
+		if err != nil {
+			log.Errorf("Failed testing condition for rule : %s. Got error %v", ruleEntry.RuleName, err)
+			if exec.observer != nil {
+				exec.observer.ruleEntryFailed(ruleEntry, err)
+			}
+			if g.ReturnErrOnFailedRuleEvaluation {
+
+				return false, err
+			}
+		}
+		// notify all listeners that a rule's when scope is been evaluated.
+		g.notifyEvaluateRuleEntry(ctx, cycle+1, ruleEntry, can)
+
+		return can, nil
+	}
+
+	// holds tells whether the when scope of the rule entry of that order holds, taking its match from the matcher
+	// if it's known. A rule entry referring to a retracted fact doesn't hold.
+	holds := func(order int) (bool, error) {
+		if refersRetractedFact(dataCtx, network.FactNames(order)) {
+
+			return false, nil
+		}
+		if matcher != nil {
+			if match, known := matcher.Match(order); known {
+
+				return match, nil
+			}
+		}
+
+		return evaluate(order, ruleEntries[order], nil)
+	}
+
+	// held are the matches of the rule entries justifying facts inserted logically, they're evaluated at the start of
+	// the cycle, so the cycle takes them instead of evaluating the rule entries again.
+	held := make(map[int]bool)
+	// retractUnjustified retracts the facts inserted logically by an activation whose rule entry's when scope doesn't
+	// hold anymore, even if the rule entry is not a candidate. As retracting a fact can break the justification of another one, it goes on until all the remaining
+	// facts are justified.
+	retractUnjustified := func() error {
+		clear(held)
+		for ctx.Err() == nil {
+			unjustified := make([]string, 0)
+			for _, fact := range defunc.Justifications.Facts() {
+				justification, _ := defunc.Justifications.Get(fact)
+				order := justification.Order
+				can, ok := held[order]
+				if !ok {
+					var err error
+					can, err = holds(order)
+					if err != nil {
+
+						return err
+					}
+					held[order] = can
+				}
+				if !can {
+					unjustified = append(unjustified, fact)
+				}
+			}
+			if len(unjustified) == 0 {
+
+				return nil
+			}
+			for _, fact := range unjustified {
+				justification, _ := defunc.Justifications.Get(fact)
+				log.Debugf("Retracting fact %s, the when scope of rule %s doesn't hold anymore", fact, justification.RuleEntry.RuleName)
+				defunc.Justifications.Remove(fact)
+				dataCtx.Retract(fact)
+				knowledge.WorkingMemory.Reset(fact)
+				dataCtx.IncrementVariableChangeCount()
+			}
+			// the retracted facts may change the matches already evaluated.
+			clear(held)
+		}
+
+		return nil
+	}
+
 	/*
 		Un-limited loop as long as there are rule to execute.
 		We need to add safety mechanism to detect unlimited loop as there are possibility executed rule are not changing
@@ -170,36 +542,98 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 		if ctx.Err() != nil {
 			log.Error("Context canceled")
 
-			return ctx.Err()
+			return cycle, StopCanceled, ctx.Err()
 		}
 
 		g.notifyBeginCycle(ctx, cycle+1)
 
+		// The facts inserted logically by an activation whose when scope doesn't hold anymore are retracted.
+		if err := retractUnjustified(); err != nil {
+
+			return cycle, StopError, err
+		}
+
 		// Select all rule entry that can be executed.
 		log.Tracef("Select all rule entry that can be executed.")
-		runnable := make([]*ast.RuleEntry, 0)
-		for _, ruleEntry := range knowledge.RuleEntries {
+		runnable := make([]*Activation, 0)
+		focus := agenda.Focus()
+		control.SetFocus(focus)
+		now := g.clock().Now()
+		if exec.debug != nil {
+			command := exec.debug.pause(&DebugPause{
+				Point:       DebugBeforeCycle,
+				Cycle:       cycle + 1,
+				AgendaGroup: focus,
+				DataContext: dataCtx,
+				Knowledge:   knowledge,
+			})
+			if command == DebugAbort {
+
+				return cycle, StopAborted, ErrDebugAborted
+			}
+		}
+		eligible := func(order int, ruleEntry *ast.RuleEntry) bool {
+
+			return !knowledge.IsRuleRetracted(ruleEntry.RuleName) && !ruleEntry.Deleted && ruleEntry.IsActive(now) && ruleEntry.GetAgendaGroup() == focus && control.CanActivate(ruleEntry) && !refersRetractedFact(dataCtx, network.FactNames(order))
+		}
+		// with the RETE network, only the candidates of the matcher are visited: the rule entries known to fail are
+		// skipped, and only those whose match is unknown are evaluated.
+		candidates := allOrders
+		if matcher != nil {
+			matching = matcher.AppendCandidates(matching[:0])
+			candidates = matching
+		}
+		unknown := func(order int, ruleEntry *ast.RuleEntry) bool {
+			if _, ok := held[order]; ok {
+
+				return false
+			}
+			if matcher != nil {
+				if _, known := matcher.Match(order); known {
+
+					return false
+				}
+			}
+
+			return eligible(order, ruleEntry)
+		}
+		// evaluate the eligible rule entries concurrently up front, the results are then handled in order.
+		var evaluations []ruleEvaluation
+		if workers := g.evaluationWorkers(exec); workers > 0 {
+			evaluations = evaluateConcurrently(ctx, dataCtx, knowledge.WorkingMemory, ruleEntries, candidates, unknown, workers, timed)
+		}
+		for _, order := range candidates {
+			ruleEntry := ruleEntries[order]
 			if ctx.Err() != nil {
 				log.Error("Context canceled")
 
-				return ctx.Err()
+				return cycle, StopCanceled, ctx.Err()
 			}
-			if !ruleEntry.Retracted && !ruleEntry.Deleted {
-				// test if this rule entry v can execute.
-				can, err := ruleEntry.Evaluate(ctx, dataCtx, knowledge.WorkingMemory)
-				if err != nil {
-					log.Errorf("Failed testing condition for rule : %s. Got error %v", ruleEntry.RuleName, err)
-					if g.ReturnErrOnFailedRuleEvaluation {
+			if matcher != nil {
+				if holds, known := matcher.Match(order); known {
+					// the match is kept, as no change propagated to this rule entry since it was evaluated.
+					if holds && eligible(order, ruleEntry) {
+						runnable = append(runnable, activations.activate(ruleEntry, cycle, order))
+					}
 
-						return err
+					continue
+				}
+			}
+			if eligible(order, ruleEntry) {
+				// test if this rule entry v can execute, unless it was evaluated as it justifies a fact.
+				can, ok := held[order]
+				if !ok {
+					var err error
+					can, err = evaluate(order, ruleEntry, evaluations)
+					if err != nil {
+
+						return cycle, StopError, err
 					}
 				}
 				// if can, add into runnable array
 				if can {
-					runnable = append(runnable, ruleEntry)
+					runnable = append(runnable, activations.activate(ruleEntry, cycle, order))
 				}
-				// notify all listeners that a rule's when scope is been evaluated.
-				g.notifyEvaluateRuleEntry(ctx, cycle+1, ruleEntry, can)
 			}
 		}
 
@@ -207,7 +641,9 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 		// knowledge.RuleContextReset()
 		log.Tracef("Selected rules %d.", len(runnable))
 
-		// If there are rules to execute, sort them by their Salience
+		activations.endCycle()
+
+		// If there are rules to execute, let the conflict resolver pick one of them
 		if len(runnable) > 0 {
 			// add the cycle counter
 			cycle++
@@ -217,48 +653,159 @@ func (g *GruleEngine) ExecuteWithContext(ctx context.Context, dataCtx ast.IDataC
 			if cycle > g.MaxCycle {
 				log.Error("Max cycle reached")
 
-				return fmt.Errorf("the GruleEngine successfully selected rule candidate for execution after %d cycles, this could possibly caused by rule entry(s) that keep added into execution pool but when executed it does not change any data in context. Please evaluate your rule entries \"When\" and \"Then\" scope. You can adjust the maximum cycle using GruleEngine.MaxCycle variable", g.MaxCycle)
+				return g.MaxCycle, StopMaxCycle, fmt.Errorf("the GruleEngine successfully selected rule candidate for execution after %d cycles, this could possibly caused by rule entry(s) that keep added into execution pool but when executed it does not change any data in context. Please evaluate your rule entries \"When\" and \"Then\" scope. You can adjust the maximum cycle using GruleEngine.MaxCycle variable", g.MaxCycle)
 			}
 
-			runner := runnable[0]
-
-			// scan all runnables and pick the highest salience
 			if len(runnable) > 1 {
-				for idx, pr := range runnable {
-					if idx > 0 && runner.Salience < pr.Salience {
-						runner = pr
-					}
+				resolver.Sort(runnable)
+			}
+			runner := runnable[0].RuleEntry
+			if exec.debug != nil {
+				command := exec.debug.pause(&DebugPause{
+					Point:       DebugBeforeExecute,
+					Cycle:       cycle,
+					AgendaGroup: focus,
+					Candidates:  runnable,
+					Rule:        runner,
+					DataContext: dataCtx,
+					Knowledge:   knowledge,
+				})
+				if command == DebugAbort {
+
+					return cycle, StopAborted, ErrDebugAborted
 				}
 			}
+			if exec.observer != nil {
+				exec.observer.cycle = cycle
+				exec.observer.entry = runner
+				if exec.trace != nil {
+					exec.observer.trace = exec.trace.addCycle(cycle, focus, runnable, resolver, knowledge.WorkingMemory)
+				}
+			}
+
+			// the activation is consumed, if the rule is still a candidate in the next cycle it is a new activation.
+			activations.consume(runnable[0])
 			// set the current rule entry to run. This is for trace ability purpose
 			dataCtx.SetRuleEntry(runner)
 			// notify listeners that we are about to execute a rule entry then scope
 			g.notifyExecuteRuleEntry(ctx, cycle, runner)
 			// execute the top most prioritized rule
+			executionStart := time.Now()
+			defunc.Justifications.Activate(ast.Justification{
+				RuleEntry: runner,
+				Cycle:     cycle,
+				Sequence:  runnable[0].Sequence,
+				Order:     runnable[0].Order,
+			})
 			err := runner.Execute(ctx, dataCtx, knowledge.WorkingMemory)
+			defunc.Justifications.Deactivate()
+			for _, timer := range exec.timers {
+				timer.RuleEntryExecuted(ctx, knowledge, cycle, runner, err, time.Since(executionStart))
+			}
 			if err != nil {
 				log.Errorf("Failed execution rule : %s. Got error %v", runner.RuleName, err)
+				if exec.observer != nil {
+					exec.observer.ruleEntryFailed(runner, err)
+				}
 
-				return fmt.Errorf("error while executing rule %s. got %w", runner.RuleName, err)
+				return cycle, StopError, fmt.Errorf("error while executing rule %s. got %w", runner.RuleName, err)
 			}
+			control.Executed(runner)
+			exec.result.fired(runner, cycle)
 
 			if dataCtx.IsComplete() {
-				break
+
+				return cycle, StopCompleted, nil
+			}
+			if exec.loops != nil {
+				if loop := exec.loops.executed(cycle, runner.RuleName, executionState(dataCtx, knowledge, agenda, control)); loop != nil {
+					log.Error(loop.Error())
+
+					return cycle, StopLoop, loop
+				}
 			}
 		} else {
+			// No more rule can be executed in the focused agenda group, give the focus back to the previous group.
+			if agenda.Pop() {
+				log.Debugf("No more rule to run in agenda group %s", focus)
+
+				continue
+			}
 			// No more rule can be executed, so we are done here.
 			log.Debugf("No more rule to run")
 
-			break
+			return cycle, StopNoMoreRule, nil
+		}
+	}
+}
+
+// activationTable keeps track of the activations of the candidate rule entries from one cycle to the next, they are
+// indexed by the order of their rule entry.
+type activationTable struct {
+	current []*Activation
+	next    []*Activation
+	// free are the activations allocated but not used yet, they are allocated by blocks.
+	free     []Activation
+	sequence uint64
+}
+
+func newActivationTable(size int) *activationTable {
+
+	return &activationTable{
+		current: make([]*Activation, size),
+		next:    make([]*Activation, size),
+	}
+}
+
+// activate returns the activation of the candidate rule entry, kept from the previous cycle if it was already
+// a candidate, and records it for the next cycle.
+func (t *activationTable) activate(ruleEntry *ast.RuleEntry, cycle uint64, order int) *Activation {
+	activation := t.current[order]
+	if activation == nil {
+		if len(t.free) == 0 {
+			t.free = make([]Activation, len(t.current))
+		}
+		activation = &t.free[0]
+		t.free = t.free[1:]
+		t.sequence++
+		*activation = Activation{
+			RuleEntry: ruleEntry,
+			Cycle:     cycle + 1,
+			Sequence:  t.sequence,
+			Order:     order,
 		}
 	}
-	log.Debugf("Finished Rules execution. With knowledge base '%s' version %s. Total #%d cycles. Duration %d ms.", knowledge.Name, knowledge.Version, cycle, time.Now().Sub(startTime).Nanoseconds()/1e6)
+	t.next[order] = activation
+
+	return activation
+}
 
-	return nil
+// endCycle makes the activations recorded during the cycle the activations of the previous cycle.
+func (t *activationTable) endCycle() {
+	t.current, t.next = t.next, t.current
+	clear(t.next)
+}
+
+// consume forgets the executed activation, if its rule entry is still a candidate in the next cycle it's a new
+// activation.
+func (t *activationTable) consume(activation *Activation) {
+	t.current[activation.Order] = nil
+}
+
+// refersRetractedFact tells whether one of the named facts is retracted from the data context.
+func refersRetractedFact(dataCtx ast.IDataContext, factNames []string) bool {
+	for _, name := range factNames {
+		if dataCtx.IsRetracted(name) {
+
+			return true
+		}
+	}
+
+	return false
 }
 
 // FetchMatchingRules function is responsible to fetch all the rules that matches to a fact against all rule entries
-// Returns []*ast.RuleEntry order by salience
+// Returns []*ast.RuleEntry ordered by the engine's conflict resolver
 func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase) ([]*ast.RuleEntry, error) {
 	if knowledge == nil || dataCtx == nil {
 
@@ -271,6 +818,8 @@ func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *as
 		Knowledge:     knowledge,
 		WorkingMemory: knowledge.WorkingMemory,
 		DataContext:   dataCtx,
+		Clock:         g.clock(),
+		Functions:     g.Functions,
 	}
 	err := dataCtx.Add("DEFUNC", defunc)
 	if err != nil {
@@ -289,9 +838,10 @@ func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *as
 	//Loop through all the rule entries available in the knowledge base and add to the response list if it is able to evaluate
 	// Select all rule entry that can be executed.
 	log.Tracef("Select all rule entry that can be executed.")
-	runnable := make([]*ast.RuleEntry, 0)
-	for _, entries := range knowledge.RuleEntries {
-		if !entries.Deleted {
+	runnable := make([]*Activation, 0)
+	now := g.clock().Now()
+	for order, entries := range knowledge.OrderedRuleEntries() {
+		if !entries.Deleted && entries.IsActive(now) && !refersRetractedFact(dataCtx, entries.FactNames()) {
 			// test if this rule entry v can execute.
 			can, err := entries.Evaluate(context.Background(), dataCtx, knowledge.WorkingMemory)
 			if err != nil {
@@ -302,17 +852,23 @@ func (g *GruleEngine) FetchMatchingRules(dataCtx ast.IDataContext, knowledge *as
 			}
 			// if can, add into runnable array
 			if can {
-				runnable = append(runnable, entries)
+				runnable = append(runnable, &Activation{
+					RuleEntry: entries,
+					Cycle:     1,
+					Sequence:  uint64(len(runnable) + 1),
+					Order:     order,
+				})
 			}
 		}
 	}
 	log.Debugf("Matching rules length %d.", len(runnable))
 	if len(runnable) > 1 {
-		sort.SliceStable(runnable, func(i, j int) bool {
-
-			return runnable[i].Salience > runnable[j].Salience
-		})
+		g.conflictResolver().Sort(runnable)
+	}
+	matching := make([]*ast.RuleEntry, len(runnable))
+	for i, activation := range runnable {
+		matching[i] = activation.RuleEntry
 	}
 
-	return runnable, nil
+	return matching, nil
 }
@@ -2,6 +2,9 @@ package engine
 
 import (
 	"context"
+	"reflect"
+	"time"
+
 	"github.com/hyperjumptech/grule-rule-engine/ast"
 )
 
@@ -14,3 +17,43 @@ type GruleEngineListener interface {
 	// BeginCycle will be called by the engine every time it start a new evaluation cycle
 	BeginCycle(ctx context.Context, cycle uint64)
 }
+
+// GruleEngineExtendedListener can be implemented by a GruleEngineListener to receive more detailed events of the execution.
+// The engine checks whether each of its listeners implements it, so a listener only has to implement GruleEngineListener.
+type GruleEngineExtendedListener interface {
+	GruleEngineListener
+	// VariableAssigned will be called by the engine after the executed rule entry assigned a variable.
+	// The old value is invalid if the variable didn't exist yet.
+	VariableAssigned(ctx context.Context, cycle uint64, entry *ast.RuleEntry, variable string, oldValue, newValue reflect.Value)
+	// FunctionCalled will be called by the engine after a function or a fact's method is called
+	// while evaluating or executing the rule entry.
+	FunctionCalled(ctx context.Context, cycle uint64, entry *ast.RuleEntry, call *FunctionCall)
+	// RuleRetracted will be called by the engine after the executed rule entry retracted a rule.
+	RuleRetracted(ctx context.Context, cycle uint64, entry *ast.RuleEntry, ruleName string)
+	// RuleEntryFailed will be called by the engine if it failed to evaluate or to execute a rule entry.
+	RuleEntryFailed(ctx context.Context, cycle uint64, entry *ast.RuleEntry, err error)
+	// ExecutionFinished will be called by the engine once the execution is over, whether it succeeded or not.
+	ExecutionFinished(ctx context.Context, result *ExecutionResult)
+}
+
+// GruleEngineTimingListener can be implemented by a GruleEngineListener to measure the executions.
+// The engine checks whether each of its listeners implements it, like GruleEngineExtendedListener.
+type GruleEngineTimingListener interface {
+	GruleEngineListener
+	// RuleEntryEvaluated will be called by the engine after it evaluated the when scope of a rule entry.
+	RuleEntryEvaluated(ctx context.Context, knowledge *ast.KnowledgeBase, cycle uint64, entry *ast.RuleEntry, candidate bool, err error, duration time.Duration)
+	// RuleEntryExecuted will be called by the engine after it executed the then scope of a rule entry.
+	RuleEntryExecuted(ctx context.Context, knowledge *ast.KnowledgeBase, cycle uint64, entry *ast.RuleEntry, err error, duration time.Duration)
+	// ExecutionEnded will be called by the engine once the execution is over, whether it succeeded or not.
+	ExecutionEnded(ctx context.Context, result *ExecutionResult)
+}
+
+// FunctionCall describes a call to a function or to a fact's method.
+type FunctionCall struct {
+	// Name is the function name, or the fact followed by the method name, eg. `Fact.GetName`.
+	Name     string
+	Args     []reflect.Value
+	Result   reflect.Value
+	Err      error
+	Duration time.Duration
+}
@@ -0,0 +1,140 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+// LoopError is the error of an execution stopped because it was looping, see GruleEngine.DetectLoops.
+type LoopError struct {
+	// Cycle is the cycle the loop was detected at.
+	Cycle uint64
+	// Rules are the rule entries executed in the loop, in execution order.
+	Rules []string
+	// Variables are the variables the loop keeps assigning, sorted.
+	Variables []string
+}
+
+func (e *LoopError) Error() string {
+	if len(e.Variables) == 0 {
+
+		return fmt.Sprintf("loop detected at cycle %d: rule entries %s keep being executed without changing the facts", e.Cycle, strings.Join(e.Rules, ", "))
+	}
+
+	return fmt.Sprintf("loop detected at cycle %d: rule entries %s keep being executed, rewriting %s", e.Cycle, strings.Join(e.Rules, ", "), strings.Join(e.Variables, ", "))
+}
+
+// loopState is the state of an execution after a cycle: the rule entry executed in the cycle and the hash of the facts,
+// of the focus stack, of the retracted rule entries and of the activation control.
+type loopState struct {
+	rule string
+	hash uint64
+}
+
+// loopCycle is a cycle made by the execution.
+type loopCycle struct {
+	rule      string
+	variables []string
+}
+
+// loopDetector tells when an execution gets back to the state it was in after an earlier cycle, the rule entries
+// executed since then would then be executed again and again.
+type loopDetector struct {
+	// states are the states the execution was in, by the index of the cycle into cycles.
+	states map[loopState]int
+	cycles []loopCycle
+	// assigned are the variables assigned during the current cycle.
+	assigned []string
+}
+
+func newLoopDetector() *loopDetector {
+
+	return &loopDetector{
+		states:   make(map[loopState]int),
+		cycles:   make([]loopCycle, 0),
+		assigned: make([]string, 0),
+	}
+}
+
+// variableAssigned records a variable assigned during the current cycle.
+func (d *loopDetector) variableAssigned(variable string) {
+	d.assigned = append(d.assigned, variable)
+}
+
+// executed records the rule entry executed in the cycle, it returns a LoopError if the execution already was in
+// the same state after an earlier cycle.
+func (d *loopDetector) executed(cycle uint64, rule string, state uint64) *LoopError {
+	d.cycles = append(d.cycles, loopCycle{rule: rule, variables: d.assigned})
+	d.assigned = make([]string, 0)
+	key := loopState{rule: rule, hash: state}
+	start, ok := d.states[key]
+	if !ok {
+		d.states[key] = len(d.cycles) - 1
+
+		return nil
+	}
+	loop := &LoopError{
+		Cycle:     cycle,
+		Rules:     make([]string, 0),
+		Variables: make([]string, 0),
+	}
+	rules := make(map[string]bool)
+	variables := make(map[string]bool)
+	for _, loopCycle := range d.cycles[start+1:] {
+		if !rules[loopCycle.rule] {
+			rules[loopCycle.rule] = true
+			loop.Rules = append(loop.Rules, loopCycle.rule)
+		}
+		for _, variable := range loopCycle.variables {
+			variables[variable] = true
+		}
+	}
+	for variable := range variables {
+		loop.Variables = append(loop.Variables, variable)
+	}
+	sort.Strings(loop.Variables)
+
+	return loop
+}
+
+// executionState hashes the facts of the data context, the focus stack, the retracted rule entries and the state of the
+// activation control, everything that decides which rule entries the next cycles can execute.
+func executionState(dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, agenda *ast.Agenda, control *activationControl) uint64 {
+	hasher := fnv.New64a()
+	keys := dataCtx.GetKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == "DEFUNC" || dataCtx.IsRetracted(key) {
+			continue
+		}
+		_, _ = fmt.Fprintf(hasher, "%s=%x;", key, pkg.HashValue(dataCtx.Get(key).Value()))
+	}
+	_, _ = fmt.Fprintf(hasher, "focus=%s;", strings.Join(agenda.FocusStack(), ","))
+	for _, ruleEntry := range knowledge.OrderedRuleEntries() {
+		if knowledge.IsRuleRetracted(ruleEntry.RuleName) {
+			_, _ = fmt.Fprintf(hasher, "retracted=%s;", ruleEntry.RuleName)
+		}
+	}
+	control.writeState(hasher)
+
+	return hasher.Sum64()
+}
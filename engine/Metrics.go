@@ -0,0 +1,406 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+var (
+	// DefaultDurationBuckets are the upper bounds, in seconds, of the when and then scope duration histograms.
+	DefaultDurationBuckets = []float64{0.00001, 0.00005, 0.0001, 0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1}
+	// DefaultCycleBuckets are the upper bounds of the cycles per execution histogram.
+	DefaultCycleBuckets = []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 5000}
+)
+
+// NewMetricsListener creates a new MetricsListener using the default histogram buckets.
+func NewMetricsListener() *MetricsListener {
+
+	return &MetricsListener{
+		DurationBuckets: DefaultDurationBuckets,
+		CycleBuckets:    DefaultCycleBuckets,
+		knowledgeBases:  make(map[knowledgeBaseKey]*knowledgeBaseMetrics),
+	}
+}
+
+// MetricsListener is a GruleEngineTimingListener that counts the evaluations, activations, firings and errors of each rule
+// and measures how long their when and then scopes take, per knowledge base name and version. It is safe to share it
+// between engines executing concurrently, the metrics of each knowledge base have their own lock so the executions of
+// different knowledge bases don't wait for each other. It is an http.Handler that exposes the metrics in the
+// Prometheus text format.
+type MetricsListener struct {
+	// DurationBuckets are the upper bounds, in seconds, of the when and then scope duration histograms.
+	// They must be sorted and can only be changed before the listener is used.
+	DurationBuckets []float64
+	// CycleBuckets are the upper bounds of the cycles per execution histogram.
+	// They must be sorted and can only be changed before the listener is used.
+	CycleBuckets []float64
+
+	// lock guards the knowledge bases map, not their metrics.
+	lock           sync.RWMutex
+	knowledgeBases map[knowledgeBaseKey]*knowledgeBaseMetrics
+}
+
+// knowledgeBaseKey identifies the metrics of a knowledge base.
+type knowledgeBaseKey struct {
+	name    string
+	version string
+}
+
+// knowledgeBaseMetrics holds the metrics of a knowledge base.
+type knowledgeBaseMetrics struct {
+	lock       sync.Mutex
+	executions uint64
+	errors     uint64
+	cycles     *histogram
+	rules      map[string]*ruleMetrics
+}
+
+// ruleMetrics holds the metrics of a rule entry.
+type ruleMetrics struct {
+	evaluations uint64
+	activations uint64
+	firings     uint64
+	errors      uint64
+	when        *histogram
+	then        *histogram
+}
+
+// histogram counts the observed values in cumulative buckets.
+type histogram struct {
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// newHistogram creates an empty histogram with the specified bucket upper bounds.
+func newHistogram(bounds []float64) *histogram {
+
+	return &histogram{
+		bounds: bounds,
+		counts: make([]uint64, len(bounds)),
+	}
+}
+
+// clone returns a copy of the histogram.
+func (h *histogram) clone() *histogram {
+
+	return &histogram{
+		bounds: h.bounds,
+		counts: append(make([]uint64, 0, len(h.counts)), h.counts...),
+		count:  h.count,
+		sum:    h.sum,
+	}
+}
+
+// observe adds the value into the histogram.
+func (h *histogram) observe(value float64) {
+	for i, bound := range h.bounds {
+		if value <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += value
+}
+
+// knowledgeBase returns the metrics of the knowledge base, they must be locked to be read or changed.
+func (m *MetricsListener) knowledgeBase(name, version string) *knowledgeBaseMetrics {
+	key := knowledgeBaseKey{name: name, version: version}
+	m.lock.RLock()
+	kb, ok := m.knowledgeBases[key]
+	m.lock.RUnlock()
+	if ok {
+
+		return kb
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	kb, ok = m.knowledgeBases[key]
+	if !ok {
+		kb = &knowledgeBaseMetrics{
+			cycles: newHistogram(m.CycleBuckets),
+			rules:  make(map[string]*ruleMetrics),
+		}
+		m.knowledgeBases[key] = kb
+	}
+
+	return kb
+}
+
+// rule returns the metrics of the rule entry, the caller must hold the lock of the knowledge base metrics.
+func (m *MetricsListener) rule(kb *knowledgeBaseMetrics, entry *ast.RuleEntry) *ruleMetrics {
+	rule, ok := kb.rules[entry.RuleName]
+	if !ok {
+		rule = &ruleMetrics{
+			when: newHistogram(m.DurationBuckets),
+			then: newHistogram(m.DurationBuckets),
+		}
+		kb.rules[entry.RuleName] = rule
+	}
+
+	return rule
+}
+
+// EvaluateRuleEntry is counted by RuleEntryEvaluated.
+func (m *MetricsListener) EvaluateRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry, candidate bool) {
+}
+
+// ExecuteRuleEntry is counted by RuleEntryExecuted.
+func (m *MetricsListener) ExecuteRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry) {
+}
+
+// BeginCycle is counted by ExecutionEnded.
+func (m *MetricsListener) BeginCycle(ctx context.Context, cycle uint64) {
+}
+
+// RuleEntryEvaluated counts the evaluation of the rule entry, and its activation or error.
+func (m *MetricsListener) RuleEntryEvaluated(ctx context.Context, knowledge *ast.KnowledgeBase, cycle uint64, entry *ast.RuleEntry, candidate bool, err error, duration time.Duration) {
+	kb := m.knowledgeBase(knowledge.Name, knowledge.Version)
+	kb.lock.Lock()
+	defer kb.lock.Unlock()
+	rule := m.rule(kb, entry)
+	rule.evaluations++
+	if candidate {
+		rule.activations++
+	}
+	if err != nil {
+		rule.errors++
+	}
+	rule.when.observe(duration.Seconds())
+}
+
+// RuleEntryExecuted counts the firing of the rule entry, and its error.
+func (m *MetricsListener) RuleEntryExecuted(ctx context.Context, knowledge *ast.KnowledgeBase, cycle uint64, entry *ast.RuleEntry, err error, duration time.Duration) {
+	kb := m.knowledgeBase(knowledge.Name, knowledge.Version)
+	kb.lock.Lock()
+	defer kb.lock.Unlock()
+	rule := m.rule(kb, entry)
+	rule.firings++
+	if err != nil {
+		rule.errors++
+	}
+	rule.then.observe(duration.Seconds())
+}
+
+// ExecutionEnded counts the execution and its cycles.
+func (m *MetricsListener) ExecutionEnded(ctx context.Context, result *ExecutionResult) {
+	kb := m.knowledgeBase(result.KnowledgeBase, result.Version)
+	kb.lock.Lock()
+	defer kb.lock.Unlock()
+	kb.executions++
+	if result.Err != nil {
+		kb.errors++
+	}
+	kb.cycles.observe(float64(result.Cycles))
+}
+
+// ServeHTTP writes the metrics in the Prometheus text format.
+func (m *MetricsListener) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	writer.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	err := m.WritePrometheus(writer)
+	if err != nil {
+		log.Errorf("Failed writing the metrics. Got error %v", err)
+	}
+}
+
+// WritePrometheus writes the metrics in the Prometheus text format.
+func (m *MetricsListener) WritePrometheus(writer io.Writer) error {
+	snapshots := m.snapshot()
+
+	out := &metricsWriter{writer: bufio.NewWriter(writer)}
+	out.header("grule_executions_total", "counter", "Number of executions of the knowledge base.")
+	for _, kb := range snapshots {
+		out.sample("grule_executions_total", kb.labels, float64(kb.executions))
+	}
+	out.header("grule_execution_errors_total", "counter", "Number of executions of the knowledge base that returned an error.")
+	for _, kb := range snapshots {
+		out.sample("grule_execution_errors_total", kb.labels, float64(kb.errors))
+	}
+	out.header("grule_execution_cycles", "histogram", "Number of cycles per execution of the knowledge base.")
+	for _, kb := range snapshots {
+		out.histogram("grule_execution_cycles", kb.labels, kb.cycles)
+	}
+
+	counters := []struct {
+		name  string
+		help  string
+		value func(rule *ruleMetrics) uint64
+	}{
+		{"grule_rule_evaluations_total", "Number of times the when scope of the rule was evaluated.", func(rule *ruleMetrics) uint64 { return rule.evaluations }},
+		{"grule_rule_activations_total", "Number of times the rule was a candidate for execution.", func(rule *ruleMetrics) uint64 { return rule.activations }},
+		{"grule_rule_firings_total", "Number of times the then scope of the rule was executed.", func(rule *ruleMetrics) uint64 { return rule.firings }},
+		{"grule_rule_errors_total", "Number of times the rule failed to be evaluated or executed.", func(rule *ruleMetrics) uint64 { return rule.errors }},
+	}
+	for _, counter := range counters {
+		out.header(counter.name, "counter", counter.help)
+		eachRule(snapshots, func(labels string, rule *ruleMetrics) {
+			out.sample(counter.name, labels, float64(counter.value(rule)))
+		})
+	}
+	out.header("grule_rule_when_duration_seconds", "histogram", "Time taken to evaluate the when scope of the rule.")
+	eachRule(snapshots, func(labels string, rule *ruleMetrics) {
+		out.histogram("grule_rule_when_duration_seconds", labels, rule.when)
+	})
+	out.header("grule_rule_then_duration_seconds", "histogram", "Time taken to execute the then scope of the rule.")
+	eachRule(snapshots, func(labels string, rule *ruleMetrics) {
+		out.histogram("grule_rule_then_duration_seconds", labels, rule.then)
+	})
+	if out.err != nil {
+
+		return out.err
+	}
+
+	return out.writer.Flush()
+}
+
+// knowledgeBaseSnapshot is a copy of the metrics of a knowledge base, taken to be written.
+type knowledgeBaseSnapshot struct {
+	labels     string
+	executions uint64
+	errors     uint64
+	cycles     *histogram
+	// rules are the names of the rules, sorted.
+	rules   []string
+	metrics map[string]*ruleMetrics
+}
+
+// snapshot copies the metrics of each knowledge base, sorted by name and version. Each knowledge base's metrics
+// are copied under its own lock, so the executions only wait for their own knowledge base to be copied.
+func (m *MetricsListener) snapshot() []*knowledgeBaseSnapshot {
+	m.lock.RLock()
+	keys := make([]knowledgeBaseKey, 0, len(m.knowledgeBases))
+	kbs := make(map[knowledgeBaseKey]*knowledgeBaseMetrics, len(m.knowledgeBases))
+	for key, kb := range m.knowledgeBases {
+		keys = append(keys, key)
+		kbs[key] = kb
+	}
+	m.lock.RUnlock()
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+
+			return keys[i].name < keys[j].name
+		}
+
+		return keys[i].version < keys[j].version
+	})
+
+	snapshots := make([]*knowledgeBaseSnapshot, len(keys))
+	for i, key := range keys {
+		kb := kbs[key]
+		kb.lock.Lock()
+		snapshot := &knowledgeBaseSnapshot{
+			labels:     kbLabels(key.name, key.version),
+			executions: kb.executions,
+			errors:     kb.errors,
+			cycles:     kb.cycles.clone(),
+			rules:      make([]string, 0, len(kb.rules)),
+			metrics:    make(map[string]*ruleMetrics, len(kb.rules)),
+		}
+		for name, rule := range kb.rules {
+			snapshot.rules = append(snapshot.rules, name)
+			snapshot.metrics[name] = &ruleMetrics{
+				evaluations: rule.evaluations,
+				activations: rule.activations,
+				firings:     rule.firings,
+				errors:      rule.errors,
+				when:        rule.when.clone(),
+				then:        rule.then.clone(),
+			}
+		}
+		kb.lock.Unlock()
+		sort.Strings(snapshot.rules)
+		snapshots[i] = snapshot
+	}
+
+	return snapshots
+}
+
+// eachRule calls fn with the labels and metrics of each rule of the knowledge bases, sorted by rule name.
+func eachRule(snapshots []*knowledgeBaseSnapshot, fn func(labels string, rule *ruleMetrics)) {
+	for _, kb := range snapshots {
+		for _, rule := range kb.rules {
+			fn(fmt.Sprintf(`%s,rule="%s"`, kb.labels, escapeLabel(rule)), kb.metrics[rule])
+		}
+	}
+}
+
+// kbLabels returns the labels of a knowledge base metric.
+func kbLabels(name, version string) string {
+
+	return fmt.Sprintf(`knowledge_base="%s",version="%s"`, escapeLabel(name), escapeLabel(version))
+}
+
+// labelEscaper escapes a label value as required by the Prometheus text format.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// escapeLabel escapes a label value.
+func escapeLabel(value string) string {
+
+	return labelEscaper.Replace(value)
+}
+
+// metricsWriter writes the Prometheus text format, keeping the first error.
+type metricsWriter struct {
+	writer *bufio.Writer
+	err    error
+}
+
+// printf writes a line unless an error already happened.
+func (w *metricsWriter) printf(format string, args ...interface{}) {
+	if w.err != nil {
+
+		return
+	}
+	_, w.err = fmt.Fprintf(w.writer, format, args...)
+}
+
+// header writes the help and type of a metric.
+func (w *metricsWriter) header(name, kind, help string) {
+	w.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// sample writes a metric value.
+func (w *metricsWriter) sample(name, labels string, value float64) {
+	w.printf("%s{%s} %s\n", name, labels, formatMetricValue(value))
+}
+
+// histogram writes the buckets, sum and count of a histogram.
+func (w *metricsWriter) histogram(name, labels string, h *histogram) {
+	for i, bound := range h.bounds {
+		w.printf("%s_bucket{%s,le=\"%s\"} %d\n", name, labels, formatMetricValue(bound), h.counts[i])
+	}
+	w.printf("%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+	w.sample(name+"_sum", labels, h.sum)
+	w.sample(name+"_count", labels, float64(h.count))
+}
+
+// formatMetricValue formats a value the shortest way.
+func formatMetricValue(value float64) string {
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,142 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const metricsRules = `
+rule Count "count to two" salience 10 {
+	when
+		Fact.Stage < 2
+	then
+		Fact.Stage = Fact.Stage + 1;
+}
+
+rule Done "fires once" {
+	when
+		Fact.Stage == 2 && Fact.Fired == ""
+	then
+		Fact.Fired = "Done";
+}
+`
+
+func TestMetricsListener(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("Metrics", "0.0.1", pkg.NewBytesResource([]byte(metricsRules)))
+	assert.NoError(t, err)
+
+	metrics := NewMetricsListener()
+	engine := NewGruleEngine()
+	engine.Listeners = []GruleEngineListener{metrics}
+	for i := 0; i < 2; i++ {
+		kb, err := lib.NewKnowledgeBaseInstance("Metrics", "0.0.1")
+		assert.NoError(t, err)
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Fact", &ConflictFact{})
+		assert.NoError(t, err)
+		err = engine.Execute(dctx, kb)
+		assert.NoError(t, err)
+	}
+
+	buff := &bytes.Buffer{}
+	err = metrics.WritePrometheus(buff)
+	assert.NoError(t, err)
+	text := buff.String()
+	// each execution makes 3 cycles and a final evaluation, Count is a candidate in the first 2.
+	for _, line := range []string{
+		"# TYPE grule_executions_total counter",
+		`grule_executions_total{knowledge_base="Metrics",version="0.0.1"} 2`,
+		`grule_execution_errors_total{knowledge_base="Metrics",version="0.0.1"} 0`,
+		`grule_execution_cycles_bucket{knowledge_base="Metrics",version="0.0.1",le="2"} 0`,
+		`grule_execution_cycles_bucket{knowledge_base="Metrics",version="0.0.1",le="5"} 2`,
+		`grule_execution_cycles_sum{knowledge_base="Metrics",version="0.0.1"} 6`,
+		`grule_rule_evaluations_total{knowledge_base="Metrics",version="0.0.1",rule="Count"} 8`,
+		`grule_rule_activations_total{knowledge_base="Metrics",version="0.0.1",rule="Count"} 4`,
+		`grule_rule_firings_total{knowledge_base="Metrics",version="0.0.1",rule="Count"} 4`,
+		`grule_rule_firings_total{knowledge_base="Metrics",version="0.0.1",rule="Done"} 2`,
+		`grule_rule_errors_total{knowledge_base="Metrics",version="0.0.1",rule="Done"} 0`,
+		"# TYPE grule_rule_when_duration_seconds histogram",
+		`grule_rule_when_duration_seconds_bucket{knowledge_base="Metrics",version="0.0.1",rule="Count",le="+Inf"} 8`,
+		`grule_rule_then_duration_seconds_count{knowledge_base="Metrics",version="0.0.1",rule="Done"} 2`,
+	} {
+		assert.Contains(t, text, line+"\n")
+	}
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Equal(t, 200, recorder.Code)
+	assert.True(t, strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/plain; version=0.0.4"))
+	assert.Equal(t, text, recorder.Body.String())
+}
+
+func TestMetricsListenerVersions(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	for _, version := range []string{"0.0.1", "0.0.2"} {
+		err := rb.BuildRuleFromResource("Metrics", version, pkg.NewBytesResource([]byte(metricsRules)))
+		assert.NoError(t, err)
+	}
+
+	// each version is executed concurrently, its metrics are kept apart.
+	metrics := NewMetricsListener()
+	engine := NewGruleEngine()
+	engine.Listeners = []GruleEngineListener{metrics}
+	var group sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		version := "0.0.1"
+		if i%4 == 0 {
+			version = "0.0.2"
+		}
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			kb, err := lib.NewKnowledgeBaseInstance("Metrics", version)
+			assert.NoError(t, err)
+			dctx := ast.NewDataContext()
+			assert.NoError(t, dctx.Add("Fact", &ConflictFact{}))
+			assert.NoError(t, engine.Execute(dctx, kb))
+		}()
+	}
+	group.Wait()
+
+	buff := &bytes.Buffer{}
+	assert.NoError(t, metrics.WritePrometheus(buff))
+	text := buff.String()
+	for _, line := range []string{
+		`grule_executions_total{knowledge_base="Metrics",version="0.0.1"} 6`,
+		`grule_executions_total{knowledge_base="Metrics",version="0.0.2"} 2`,
+		`grule_rule_firings_total{knowledge_base="Metrics",version="0.0.1",rule="Done"} 6`,
+		`grule_rule_firings_total{knowledge_base="Metrics",version="0.0.2",rule="Done"} 2`,
+	} {
+		assert.Contains(t, text, line+"\n")
+	}
+	assert.Less(t, strings.Index(text, `version="0.0.1"`), strings.Index(text, `version="0.0.2"`))
+}
+
+func TestEscapeLabel(t *testing.T) {
+	assert.Equal(t, `a\"b\\c\nd`, escapeLabel("a\"b\\c\nd"))
+}
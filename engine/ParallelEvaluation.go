@@ -0,0 +1,89 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// ruleEvaluation is the outcome of the evaluation of a rule entry's when scope.
+type ruleEvaluation struct {
+	can      bool
+	err      error
+	duration time.Duration
+}
+
+// evaluateRuleEntry evaluates the when scope of the rule entry, it measures how long it took if it's timed.
+func evaluateRuleEntry(ctx context.Context, dataCtx ast.IDataContext, memory *ast.WorkingMemory, ruleEntry *ast.RuleEntry, timed bool) ruleEvaluation {
+	if !timed {
+		can, err := ruleEntry.Evaluate(ctx, dataCtx, memory)
+
+		return ruleEvaluation{can: can, err: err}
+	}
+	start := time.Now()
+	can, err := ruleEntry.Evaluate(ctx, dataCtx, memory)
+
+	return ruleEvaluation{
+		can:      can,
+		err:      err,
+		duration: time.Since(start),
+	}
+}
+
+// evaluateConcurrently evaluates the when scope of the eligible rule entries among the candidates, given by their
+// order, across at most workers goroutines. The evaluations are indexed by the order of the rule entries, so the
+// caller can go through them in the evaluation order and the conflict resolution stays deterministic. The working
+// memory is concurrent until all the evaluations are done.
+func evaluateConcurrently(ctx context.Context, dataCtx ast.IDataContext, memory *ast.WorkingMemory, ruleEntries []*ast.RuleEntry, candidates []int, eligible func(order int, ruleEntry *ast.RuleEntry) bool, workers int, timed bool) []ruleEvaluation {
+	evaluations := make([]ruleEvaluation, len(ruleEntries))
+	orders := make([]int, 0, len(candidates))
+	for _, order := range candidates {
+		if eligible(order, ruleEntries[order]) {
+			orders = append(orders, order)
+		}
+	}
+	if workers > len(orders) {
+		workers = len(orders)
+	}
+
+	memory.SetConcurrent(true)
+	defer memory.SetConcurrent(false)
+
+	next := int64(-1)
+	var group sync.WaitGroup
+	group.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer group.Done()
+			for {
+				index := int(atomic.AddInt64(&next, 1))
+				if index >= len(orders) {
+
+					return
+				}
+				order := orders[index]
+				evaluations[order] = evaluateRuleEntry(ctx, dataCtx, memory, ruleEntries[order], timed)
+			}
+		}()
+	}
+	group.Wait()
+
+	return evaluations
+}
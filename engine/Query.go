@@ -0,0 +1,137 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+)
+
+// QueryResult is the answer to a query asked with GruleEngine.Query.
+type QueryResult struct {
+	// Query is the name of the query asked.
+	Query string
+	// Answer tells whether the query holds.
+	Answer bool
+	// Chain are the rule entries that proved the query, in the order they were executed. A rule entry executed for
+	// a when scope that couldn't be proven is not in it, and it's empty if the answer is false. The query holds
+	// without any rule entry if it's empty and the answer is true.
+	Chain []*ast.RuleEntry
+}
+
+// RuleNames returns the names of the rule entries in the chain, in the order they were executed.
+func (result *QueryResult) RuleNames() []string {
+	names := make([]string, len(result.Chain))
+	for i, ruleEntry := range result.Chain {
+		names[i] = ruleEntry.RuleName
+	}
+
+	return names
+}
+
+// Query asks the knowledge base's query with the specified name, instead of executing all of its rule entries.
+// The query is evaluated goal-first: if it doesn't hold, the rule entries whose then scope assigns a variable
+// the query reads are tried, by descending salience then in the order they were declared. A rule entry is executed
+// once its when scope holds, proven the same way, and the query is evaluated again. Each rule entry is executed at
+// most once. The arguments are added to the data context under the names of the query's parameters, if none is
+// given the data context must already hold them. The changes made to the facts are rolled back once the query is
+// answered, except those the facts' own functions make.
+func (g *GruleEngine) Query(ctx context.Context, dataCtx ast.IDataContext, knowledge *ast.KnowledgeBase, queryName string, arguments ...interface{}) (*QueryResult, error) {
+	if knowledge == nil || dataCtx == nil {
+
+		return nil, fmt.Errorf("nil KnowledgeBase or DataContext is not allowed")
+	}
+	query, ok := knowledge.GetQueryEntry(queryName)
+	if !ok {
+
+		return nil, fmt.Errorf("query %s not found in knowledge base %s:%s", queryName, knowledge.Name, knowledge.Version)
+	}
+	if len(arguments) > 0 && len(arguments) != len(query.Parameters) {
+
+		return nil, fmt.Errorf("query %s expects %d arguments, got %d", queryName, len(query.Parameters), len(arguments))
+	}
+
+	log.Debugf("Asking query %s to knowledge '%s' version %s", queryName, knowledge.Name, knowledge.Version)
+	transaction := ast.NewTransactionDataContext(dataCtx)
+	defer func() {
+		if err := transaction.Rollback(); err != nil {
+			log.Errorf("Rolling back the changes made by query %s failed, got %v", queryName, err)
+		}
+	}()
+	for i, argument := range arguments {
+		err := transaction.Add(query.Parameters[i], argument)
+		if err != nil {
+
+			return nil, fmt.Errorf("adding argument %s of query %s. got %w", query.Parameters[i], queryName, err)
+		}
+	}
+	for _, parameter := range query.Parameters {
+		if transaction.Get(parameter) == nil {
+
+			return nil, fmt.Errorf("query %s parameter %s is not in the data context", queryName, parameter)
+		}
+	}
+
+	// Prepare the build-in function and add to datacontext.
+	defunc := &ast.BuiltInFunctions{
+		Knowledge:      knowledge,
+		WorkingMemory:  knowledge.WorkingMemory,
+		DataContext:    transaction,
+		Agenda:         g.newAgenda(g.initialFocus()),
+		Clock:          g.clock(),
+		Functions:      g.Functions,
+		Justifications: ast.NewJustifications(),
+	}
+	err := transaction.Add("DEFUNC", defunc)
+	if err != nil {
+		log.Error("DEFUNC add err")
+
+		return nil, err
+	}
+
+	// Working memory need to be resetted. all Expression will be set as not evaluated.
+	knowledge.WorkingMemory.ResetAll()
+	defer knowledge.WorkingMemory.ResetAll()
+	knowledge.Reset()
+	knowledge.InitializeContext(transaction)
+
+	now := g.clock().Now()
+	candidates := make([]*ast.RuleEntry, 0, len(knowledge.RuleEntries))
+	for _, ruleEntry := range knowledge.OrderedRuleEntries() {
+		if !ruleEntry.Deleted && ruleEntry.IsActive(now) && !refersRetractedFact(transaction, ruleEntry.FactNames()) {
+			candidates = append(candidates, ruleEntry)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+
+		return candidates[i].Salience > candidates[j].Salience
+	})
+
+	answer, chain, err := query.Prove(ctx, transaction, knowledge.WorkingMemory, candidates)
+	if err != nil {
+
+		return nil, err
+	}
+	log.Debugf("Query %s answered %v, executed %d rule entries", queryName, answer, len(chain))
+
+	return &QueryResult{
+		Query:  queryName,
+		Answer: answer,
+		Chain:  chain,
+	}, nil
+}
@@ -0,0 +1,170 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const agendaGroupGRL = `
+rule Start "start the order processing" {
+	when
+		Order.Started == false
+	then
+		Order.Started = true;
+		Order.Trail = Order.Trail + "Start,";
+		SetFocus("tax");
+		SetFocus("pricing");
+		SetFocus("validation");
+}
+
+rule Validate "validate the order" salience 10 group "validation" {
+	when
+		Order.Validated == false
+	then
+		Order.Validated = true;
+		Order.Trail = Order.Trail + "Validate,";
+}
+
+rule Price "price the order" group "pricing" {
+	when
+		Order.Price == 0
+	then
+		Order.Price = 100;
+		Order.Trail = Order.Trail + "Price,";
+}
+
+rule Tax "tax the order" group "tax" {
+	when
+		Order.Tax == 0 && Order.Price > 0
+	then
+		Order.Tax = Order.Price / 10;
+		Order.Trail = Order.Trail + "Tax,";
+}
+
+rule Audit "never focused" group "audit" {
+	when
+		true
+	then
+		Order.Trail = Order.Trail + "Audit,";
+		Retract("Audit");
+}
+`
+
+type AgendaOrder struct {
+	Started   bool
+	Validated bool
+	Price     int64
+	Tax       int64
+	Trail     string
+}
+
+func executeAgendaGroup(t *testing.T, eng *engine.GruleEngine) *AgendaOrder {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("AgendaTest", "0.0.1", pkg.NewBytesResource([]byte(agendaGroupGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("AgendaTest", "0.0.1")
+	assert.NoError(t, err)
+
+	order := &AgendaOrder{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Order", order)
+	assert.NoError(t, err)
+	err = eng.Execute(dctx, kb)
+	assert.NoError(t, err)
+
+	return order
+}
+
+func TestAgendaGroupSetFocusFromGRL(t *testing.T) {
+	order := executeAgendaGroup(t, engine.NewGruleEngine())
+	assert.Equal(t, "Start,Validate,Price,Tax,", order.Trail)
+	assert.Equal(t, int64(10), order.Tax)
+}
+
+func TestAgendaGroupSetFocusFromEngine(t *testing.T) {
+	eng := engine.NewGruleEngine()
+	eng.SetFocus("validation", "pricing", "tax")
+	order := executeAgendaGroup(t, eng)
+	assert.Equal(t, "Validate,Price,Tax,Start,", order.Trail)
+}
+
+func TestAgendaGroupExecuteWithFocus(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("AgendaTest", "0.0.1", pkg.NewBytesResource([]byte(agendaGroupGRL)))
+	assert.NoError(t, err)
+	eng := engine.NewGruleEngine()
+	eng.SetFocus("pricing")
+
+	// the focus given to an execution replaces the engine's focus, for that execution only.
+	execute := func(groups ...string) *AgendaOrder {
+		kb, err := lib.NewKnowledgeBaseInstance("AgendaTest", "0.0.1")
+		assert.NoError(t, err)
+		order := &AgendaOrder{}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Order", order))
+		_, err = eng.ExecuteWithFocus(context.Background(), dctx, kb, groups...)
+		assert.NoError(t, err)
+
+		return order
+	}
+	assert.Equal(t, "Validate,Price,Tax,Start,", execute("validation", "pricing", "tax").Trail)
+	assert.Equal(t, "Start,Validate,Price,Tax,", execute().Trail)
+
+	order := executeAgendaGroup(t, eng)
+	assert.Equal(t, "Price,Start,Validate,Tax,", order.Trail)
+}
+
+func TestAgendaGroupCatalog(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("AgendaTest", "0.0.1", pkg.NewBytesResource([]byte(agendaGroupGRL)))
+	assert.NoError(t, err)
+
+	kb := lib.GetKnowledgeBase("AgendaTest", "0.0.1")
+	assert.Equal(t, "pricing", kb.RuleEntries["Price"].AgendaGroup)
+	assert.Equal(t, ast.MainAgendaGroup, kb.RuleEntries["Start"].GetAgendaGroup())
+	assert.Equal(t, 10, kb.RuleEntries["Validate"].Salience)
+
+	buff := &bytes.Buffer{}
+	err = lib.StoreKnowledgeBaseToWriter(buff, "AgendaTest", "0.0.1")
+	assert.NoError(t, err)
+	loaded, err := ast.NewKnowledgeLibrary().LoadKnowledgeBaseFromReader(buff, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "tax", loaded.RuleEntries["Tax"].AgendaGroup)
+	assert.Equal(t, "", loaded.RuleEntries["Start"].AgendaGroup)
+}
+
+func TestAgendaGroupInvalidAttribute(t *testing.T) {
+	for _, grl := range []string{
+		`rule Bad "group must be a string" group true { when true then Retract("Bad"); }`,
+		`rule Bad "unknown attribute" colour "red" { when true then Retract("Bad"); }`,
+	} {
+		lib := ast.NewKnowledgeLibrary()
+		rb := builder.NewRuleBuilder(lib)
+		err := rb.BuildRuleFromResource("AgendaTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+		assert.Error(t, err)
+	}
+}
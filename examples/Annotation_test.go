@@ -0,0 +1,146 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const annotationGRL = `
+@owner("risk-team") @ticket("RISK-42")
+@since('2024-01-01')
+rule CheckLimit "check the credit limit" salience 10 {
+	when
+		Fact.Count == 0
+	then
+		Fact.Count = 1;
+}
+
+@owner("pricing-team")
+rule ApplyDiscount "apply the discount" {
+	when
+		Fact.Count == 1
+	then
+		Fact.Count = 2;
+}
+
+rule NotAnnotated "no annotation" {
+	when
+		Fact.Count == 2
+	then
+		Fact.Count = 3;
+}
+`
+
+// AnnotationAuditListener records the owner of each executed rule entry.
+type AnnotationAuditListener struct {
+	Owners []string
+}
+
+// EvaluateRuleEntry is not used by this listener.
+func (l *AnnotationAuditListener) EvaluateRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry, candidate bool) {
+}
+
+// ExecuteRuleEntry records the owner of the executed rule entry.
+func (l *AnnotationAuditListener) ExecuteRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry) {
+	if owner, ok := entry.GetAnnotation("owner"); ok {
+		l.Owners = append(l.Owners, owner)
+	}
+}
+
+// BeginCycle is not used by this listener.
+func (l *AnnotationAuditListener) BeginCycle(ctx context.Context, cycle uint64) {
+}
+
+func TestRuleAnnotations(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("AnnotationTest", "0.0.1", pkg.NewBytesResource([]byte(annotationGRL)))
+	assert.NoError(t, err)
+
+	kb := lib.GetKnowledgeBase("AnnotationTest", "0.0.1")
+	assert.Equal(t, map[string]string{"owner": "risk-team", "ticket": "RISK-42", "since": "2024-01-01"}, kb.RuleEntries["CheckLimit"].Annotations)
+	assert.Nil(t, kb.RuleEntries["NotAnnotated"].Annotations)
+	assert.Equal(t, 10, kb.RuleEntries["CheckLimit"].Salience)
+	assert.Equal(t, []string{"CheckLimit"}, ruleNames(kb.RuleEntriesWithAnnotation("owner", "risk-team")))
+	assert.Empty(t, kb.RuleEntriesWithAnnotation("owner", "nobody"))
+
+	ticket, ok := kb.RuleEntries["CheckLimit"].GetAnnotation("ticket")
+	assert.True(t, ok)
+	assert.Equal(t, "RISK-42", ticket)
+	_, ok = kb.RuleEntries["ApplyDiscount"].GetAnnotation("ticket")
+	assert.False(t, ok)
+
+	// the catalog keeps the annotations.
+	buff := &bytes.Buffer{}
+	err = lib.StoreKnowledgeBaseToWriter(buff, "AnnotationTest", "0.0.1")
+	assert.NoError(t, err)
+	loaded, err := ast.NewKnowledgeLibrary().LoadKnowledgeBaseFromReader(buff, true)
+	assert.NoError(t, err)
+	assert.Equal(t, kb.RuleEntries["CheckLimit"].Annotations, loaded.RuleEntries["CheckLimit"].Annotations)
+	assert.Equal(t, []string{"ApplyDiscount"}, ruleNames(loaded.RuleEntriesWithAnnotation("owner", "pricing-team")))
+
+	// listeners can route the executed rules using their annotations.
+	instance, err := lib.NewKnowledgeBaseInstance("AnnotationTest", "0.0.1")
+	assert.NoError(t, err)
+	instance.RuleEntries["CheckLimit"].Annotations["owner"] = "changed"
+	assert.Equal(t, "risk-team", kb.RuleEntries["CheckLimit"].Annotations["owner"])
+	instance, err = lib.NewKnowledgeBaseInstance("AnnotationTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", &AttributeFact{})
+	assert.NoError(t, err)
+	listener := &AnnotationAuditListener{}
+	eng := engine.NewGruleEngine()
+	eng.Listeners = append(eng.Listeners, listener)
+	err = eng.Execute(dctx, instance)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"risk-team", "pricing-team"}, listener.Owners)
+}
+
+func TestRuleAnnotationsFromJSON(t *testing.T) {
+	resource, err := pkg.NewJSONResourceFromResource(pkg.NewBytesResource([]byte(`[{
+		"name": "Tagged",
+		"desc": "tagged from JSON",
+		"annotations": {"owner": "risk-team"},
+		"when": "Fact.Count == 0",
+		"then": ["Fact.Count = 1"]
+	}]`)))
+	assert.NoError(t, err)
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err = rb.BuildRuleFromResource("AnnotationTest", "0.0.1", resource)
+	assert.NoError(t, err)
+	kb := lib.GetKnowledgeBase("AnnotationTest", "0.0.1")
+	assert.Equal(t, map[string]string{"owner": "risk-team"}, kb.RuleEntries["Tagged"].Annotations)
+}
+
+func TestDuplicateRuleAnnotation(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("AnnotationTest", "0.0.1", pkg.NewBytesResource([]byte(`
+@owner("a") @owner("b")
+rule Duplicate { when true then Retract("Duplicate"); }`)))
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+const batchGRL = `
+rule Small "small count" {
+	when
+		Fact.Count < 10 && Fact.Trail == ""
+	then
+		Fact.Trail = "small";
+		Retract("Small");
+}
+
+rule Large "large count" {
+	when
+		Fact.Count >= 10 && Fact.Trail == ""
+	then
+		Fact.Trail = "large";
+		Retract("Large");
+}
+`
+
+func TestKnowledgeBasePool(t *testing.T) {
+	lib := buildAttributeKnowledgeBase(t, batchGRL)
+	_, err := lib.NewKnowledgeBasePool("Missing", "0.0.1", 2)
+	assert.Error(t, err)
+	_, err = lib.NewKnowledgeBasePool("AttributeTest", "0.0.1", 0)
+	assert.Error(t, err)
+
+	pool, err := lib.NewKnowledgeBasePool("AttributeTest", "0.0.1", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pool.Idle())
+
+	first, err := pool.Get()
+	assert.NoError(t, err)
+	second, err := pool.Get()
+	assert.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.True(t, lib.GetKnowledgeBase("AttributeTest", "0.0.1").IsIdentical(second))
+
+	// the instances are reset when they are given back.
+	first.RetractRule("Small")
+	pool.Put(first)
+	pool.Put(second)
+	pool.Put(lib.GetKnowledgeBase("AttributeTest", "0.0.1"))
+	assert.Equal(t, 2, pool.Idle())
+	recycled, err := pool.Get()
+	assert.NoError(t, err)
+	assert.Same(t, first, recycled)
+	assert.False(t, recycled.IsRuleRetracted("Small"))
+}
+
+func TestExecuteBatch(t *testing.T) {
+	lib := buildAttributeKnowledgeBase(t, batchGRL)
+	pool, err := lib.NewKnowledgeBasePool("AttributeTest", "0.0.1", 4)
+	assert.NoError(t, err)
+
+	facts := make([]*AttributeFact, 100)
+	dataContexts := make([]ast.IDataContext, len(facts)+1)
+	for i := range facts {
+		facts[i] = &AttributeFact{Count: i % 20}
+		dataContexts[i] = ast.NewDataContext()
+		err := dataContexts[i].Add("Fact", facts[i])
+		assert.NoError(t, err)
+	}
+
+	eng := engine.NewGruleEngine()
+	eng.BatchWorkers = 8
+	results, err := eng.ExecuteBatch(context.Background(), pool, dataContexts)
+	assert.NoError(t, err)
+	assert.Len(t, results, len(dataContexts))
+	for i, fact := range facts {
+		assert.NoError(t, results[i].Err)
+		assert.Equal(t, engine.StopNoMoreRule, results[i].Result.StopReason)
+		assert.Len(t, results[i].Result.FiredRules, 1)
+		if fact.Count < 10 {
+			assert.Equal(t, "small", fact.Trail)
+		} else {
+			assert.Equal(t, "large", fact.Trail)
+		}
+	}
+	// the missing data context fails alone.
+	assert.Error(t, results[len(facts)].Err)
+	assert.LessOrEqual(t, pool.Idle(), 4)
+
+	_, err = eng.ExecuteBatch(context.Background(), nil, dataContexts)
+	assert.Error(t, err)
+
+	// the data contexts of a canceled batch are not executed, and the batch fails.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err = eng.ExecuteBatch(ctx, pool, dataContexts[:10])
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, results, 10)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+		assert.Nil(t, result.Result)
+	}
+}
+
+func TestExecuteBatchInterrupted(t *testing.T) {
+	lib := buildAttributeKnowledgeBase(t, batchGRL)
+	pool, err := lib.NewKnowledgeBasePool("AttributeTest", "0.0.1", 1)
+	assert.NoError(t, err)
+
+	// the batch is canceled by the listener of the first execution, the other data contexts are not executed.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	eng := engine.NewGruleEngine()
+	eng.BatchWorkers = 1
+	eng.Listeners = append(eng.Listeners, &cancelingListener{cancel: cancel})
+	dataContexts := make([]ast.IDataContext, 3)
+	for i := range dataContexts {
+		dataContexts[i] = ast.NewDataContext()
+		assert.NoError(t, dataContexts[i].Add("Fact", &AttributeFact{}))
+	}
+	results, err := eng.ExecuteBatch(ctx, pool, dataContexts)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotNil(t, results[0].Result)
+	assert.ErrorIs(t, results[0].Err, context.Canceled)
+	for _, result := range results[1:] {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+		assert.Nil(t, result.Result)
+	}
+}
+
+// cancelingListener cancels the context once a rule entry is executed.
+type cancelingListener struct {
+	cancel context.CancelFunc
+}
+
+func (l *cancelingListener) EvaluateRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry, candidate bool) {
+}
+
+func (l *cancelingListener) ExecuteRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry) {
+	l.cancel()
+}
+
+func (l *cancelingListener) BeginCycle(ctx context.Context, cycle uint64) {
+}
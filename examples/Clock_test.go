@@ -0,0 +1,77 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const clockGRL = `
+rule NewYear "happy new year" {
+	when
+		Fact.Count == 0 && GetTimeMonth(Now()) == 1 && GetTimeDay(Now()) == 1
+	then
+		Fact.Count = 1;
+		Fact.Trail = TimeFormat(Now(), "2006-01-02 15:04");
+}
+`
+
+func TestEngineClock(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ClockTest", "0.0.1", pkg.NewBytesResource([]byte(clockGRL)))
+	assert.NoError(t, err)
+
+	clock := pkg.NewFixedClock(time.Date(2020, 12, 31, 23, 30, 0, 0, time.Local))
+	eng := engine.NewGruleEngine()
+	eng.Clock = clock
+
+	execute := func() *AttributeFact {
+		kb, err := lib.NewKnowledgeBaseInstance("ClockTest", "0.0.1")
+		assert.NoError(t, err)
+		fact := &AttributeFact{}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Fact", fact)
+		assert.NoError(t, err)
+		err = eng.Execute(dctx, kb)
+		assert.NoError(t, err)
+
+		return fact
+	}
+	assert.Equal(t, 0, execute().Count)
+
+	// replay the execution as if it happens one hour later.
+	clock.Advance(time.Hour)
+	fact := execute()
+	assert.Equal(t, 1, fact.Count)
+	assert.Equal(t, "2021-01-01 00:30", fact.Trail)
+
+	// the clock is also used when fetching the matching rules.
+	kb, err := lib.NewKnowledgeBaseInstance("ClockTest", "0.0.1")
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", &AttributeFact{})
+	assert.NoError(t, err)
+	matching, err := eng.FetchMatchingRules(dctx, kb)
+	assert.NoError(t, err)
+	assert.Len(t, matching, 1)
+}
@@ -0,0 +1,100 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const customFunctionGRL = `
+rule Discount "apply the discount" {
+	when
+		Fact.Count == 0 && IsVIP(Fact.Trail)
+	then
+		Fact.Count = Discount(100, 15);
+		Fact.Trail = Shout(Fact.Trail);
+}
+`
+
+func TestCustomFunctions(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	assert.NoError(t, lib.Functions.Register("IsVIP", func(name string) bool { return strings.HasPrefix(name, "vip") }))
+	assert.NoError(t, lib.Functions.Register("Discount", func(price, percent int) int { return price - price*percent/100 }))
+	assert.NoError(t, lib.Functions.Register("Shout", strings.ToUpper))
+
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("CustomFunction", "0.0.1", pkg.NewBytesResource([]byte(customFunctionGRL)))
+	assert.NoError(t, err)
+
+	execute := func(eng *engine.GruleEngine, name string) *AttributeFact {
+		kb, err := lib.NewKnowledgeBaseInstance("CustomFunction", "0.0.1")
+		assert.NoError(t, err)
+		fact := &AttributeFact{Trail: name}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Fact", fact)
+		assert.NoError(t, err)
+		err = eng.Execute(dctx, kb)
+		assert.NoError(t, err)
+
+		return fact
+	}
+
+	fact := execute(engine.NewGruleEngine(), "vip-alice")
+	assert.Equal(t, 85, fact.Count)
+	assert.Equal(t, "VIP-ALICE", fact.Trail)
+	assert.Equal(t, 0, execute(engine.NewGruleEngine(), "bob").Count)
+
+	// the engine's functions replace the library's functions of the same name.
+	eng := engine.NewGruleEngine()
+	eng.Functions = ast.NewFunctionRegistry()
+	assert.NoError(t, eng.Functions.Register("IsVIP", func(name string) bool { return true }))
+	assert.Equal(t, 85, execute(eng, "bob").Count)
+}
+
+func TestCustomFunctionsAreCheckedWhenBuilding(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	assert.NoError(t, lib.Functions.Register("IsVIP", func(name string) bool { return true }))
+	rb := builder.NewRuleBuilder(lib)
+	rb.CheckFunctionCalls = true
+
+	err := rb.BuildRuleFromResource("Unknown", "0.0.1", pkg.NewBytesResource([]byte(`
+rule Unknown "calls an unknown function" {
+	when
+		IsGold(Fact.Trail)
+	then
+		Complete();
+}`)))
+	reporter, ok := err.(*pkg.GruleErrorReporter)
+	assert.True(t, ok)
+	assert.EqualError(t, reporter.Errors[0], "grl error on 4:2 function IsGold is neither a built-in function nor a registered function")
+
+	err = rb.BuildRuleFromResource("Arity", "0.0.1", pkg.NewBytesResource([]byte(`
+rule Arity "calls with too many arguments" {
+	when
+		IsVIP(Fact.Trail, 1)
+	then
+		Retract("Arity", "Arity");
+}`)))
+	reporter, ok = err.(*pkg.GruleErrorReporter)
+	assert.True(t, ok)
+	assert.EqualError(t, reporter.Errors[0], "grl error on 4:2 function IsVIP needs 1 arguments, called with 2")
+}
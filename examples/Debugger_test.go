@@ -0,0 +1,162 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const debuggerGRL = `
+rule First "first" salience 10 {
+	when
+		Fact.Count == 0
+	then
+		Fact.Count = 1;
+}
+
+rule Second "second" {
+	when
+		Fact.Count == 1
+	then
+		Fact.Count = 2;
+}
+
+rule Third "third" {
+	when
+		Fact.Count == 2
+	then
+		Fact.Count = 3;
+		Complete();
+}
+`
+
+func newDebuggerExecution(t *testing.T) (*AttributeFact, ast.IDataContext, *ast.KnowledgeBase) {
+	t.Helper()
+	lib := buildAttributeKnowledgeBase(t, debuggerGRL)
+	kb, err := lib.NewKnowledgeBaseInstance("AttributeTest", "0.0.1")
+	assert.NoError(t, err)
+	fact := &AttributeFact{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	return fact, dctx, kb
+}
+
+func TestDebuggerRuleBreakpoint(t *testing.T) {
+	fact, dctx, kb := newDebuggerExecution(t)
+	pauses := make([]*engine.DebugPause, 0)
+	counts := make([]int, 0)
+	session := engine.NewDebugSession(func(pause *engine.DebugPause) engine.DebugCommand {
+		pauses = append(pauses, pause)
+		counts = append(counts, pause.Facts()["Fact"].(*AttributeFact).Count)
+
+		return engine.DebugContinue
+	})
+	session.BreakOnRule("Second")
+	result, err := engine.NewGruleEngine().Debug(context.Background(), dctx, kb, session)
+	assert.NoError(t, err)
+	assert.Equal(t, engine.StopCompleted, result.StopReason)
+	assert.Equal(t, 3, fact.Count)
+
+	assert.Len(t, pauses, 1)
+	assert.Equal(t, engine.DebugBeforeExecute, pauses[0].Point)
+	assert.Equal(t, "Second", pauses[0].Breakpoint)
+	assert.Equal(t, "Second", pauses[0].Rule.RuleName)
+	assert.Equal(t, uint64(2), pauses[0].Cycle)
+	assert.Equal(t, []int{1}, counts)
+}
+
+func TestDebuggerStep(t *testing.T) {
+	_, dctx, kb := newDebuggerExecution(t)
+	points := make([]string, 0)
+	session := engine.NewDebugSession(func(pause *engine.DebugPause) engine.DebugCommand {
+		if pause.Point == engine.DebugBeforeExecute {
+			points = append(points, pause.Rule.RuleName)
+			assert.Equal(t, pause.Rule, pause.Candidates[0].RuleEntry)
+		} else {
+			points = append(points, string(pause.Point))
+		}
+
+		return engine.DebugStep
+	})
+	session.BreakOnStart()
+	_, err := engine.NewGruleEngine().Debug(context.Background(), dctx, kb, session)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"before-cycle", "First", "before-cycle", "Second", "before-cycle", "Third"}, points)
+}
+
+const debuggerExpressionsGRL = `
+rule Big "the condition breakpoint" {
+	when
+		Fact.Count > 1
+	then
+		Complete();
+}
+
+rule Tenfold "the expression to print" {
+	when
+		Fact.Count * 10
+	then
+		Complete();
+}
+`
+
+func TestDebuggerConditionAndAbort(t *testing.T) {
+	fact, dctx, kb := newDebuggerExecution(t)
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("Expressions", "0.0.1", pkg.NewBytesResource([]byte(debuggerExpressionsGRL)))
+	assert.NoError(t, err)
+	expressions := lib.GetKnowledgeBase("Expressions", "0.0.1")
+
+	session := engine.NewDebugSession(func(pause *engine.DebugPause) engine.DebugCommand {
+		assert.Equal(t, engine.DebugBeforeCycle, pause.Point)
+		assert.Equal(t, "Fact.Count>1", pause.Breakpoint)
+		value, err := pause.Evaluate(expressions, "Tenfold")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(20), value.Int())
+		_, err = pause.Evaluate(expressions, "Unknown")
+		assert.Error(t, err)
+
+		// the executed knowledge base's own when scopes can be evaluated, without changing its working memory.
+		value, err = pause.Evaluate(pause.Knowledge, "Third")
+		assert.NoError(t, err)
+		assert.True(t, value.Bool())
+
+		return engine.DebugAbort
+	})
+	assert.Error(t, session.BreakWhen(expressions, "Unknown"))
+	assert.Error(t, session.BreakWhen(nil, "Big"))
+	assert.NoError(t, session.BreakWhen(expressions, "Big"))
+	session.BreakOnRule("First")
+	assert.True(t, session.RemoveBreakpoint("First"))
+	assert.False(t, session.RemoveBreakpoint("First"))
+	assert.Equal(t, []string{"Fact.Count>1"}, session.Breakpoints())
+
+	result, err := engine.NewGruleEngine().Debug(context.Background(), dctx, kb, session)
+	assert.ErrorIs(t, err, engine.ErrDebugAborted)
+	assert.Equal(t, engine.StopAborted, result.StopReason)
+	assert.Equal(t, 2, fact.Count)
+
+	_, err = engine.NewGruleEngine().Debug(context.Background(), dctx, kb, nil)
+	assert.Error(t, err)
+}
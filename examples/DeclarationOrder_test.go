@@ -0,0 +1,115 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	declarationFirstGRL = `
+rule Zulu "first declared" {
+	when
+		Fact.Counter < 10
+	then
+		Fact.Trail = Fact.Trail + "Zulu,";
+		Retract("Zulu");
+}
+
+rule Alpha "second declared" {
+	when
+		Fact.Counter < 10
+	then
+		Fact.Trail = Fact.Trail + "Alpha,";
+		Retract("Alpha");
+}
+`
+	declarationSecondGRL = `
+rule Mike "third declared" {
+	when
+		Fact.Counter < 10
+	then
+		Fact.Trail = Fact.Trail + "Mike,";
+		Retract("Mike");
+}
+`
+)
+
+type DeclarationFact struct {
+	Counter int
+	Trail   string
+}
+
+func ruleNames(entries []*ast.RuleEntry) []string {
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.RuleName
+	}
+
+	return names
+}
+
+func TestDeclarationOrder(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResources("DeclarationTest", "0.0.1", []pkg.Resource{
+		pkg.NewBytesResource([]byte(declarationFirstGRL)),
+		pkg.NewBytesResource([]byte(declarationSecondGRL)),
+	})
+	assert.NoError(t, err)
+
+	kb := lib.GetKnowledgeBase("DeclarationTest", "0.0.1")
+	assert.Equal(t, []string{"Zulu", "Alpha", "Mike"}, ruleNames(kb.OrderedRuleEntries()))
+
+	mike := kb.RuleEntries["Mike"].Declaration
+	assert.Equal(t, 1, mike.ResourceIndex)
+	assert.Equal(t, 0, mike.Position)
+	assert.Equal(t, 2, mike.Line)
+	assert.Contains(t, mike.Resource, "Byte array resources")
+	assert.Equal(t, 1, kb.RuleEntries["Alpha"].Declaration.Position)
+
+	// the instance and the deserialized knowledge base keep the same order.
+	instance, err := lib.NewKnowledgeBaseInstance("DeclarationTest", "0.0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Zulu", "Alpha", "Mike"}, ruleNames(instance.OrderedRuleEntries()))
+
+	buff := &bytes.Buffer{}
+	err = lib.StoreKnowledgeBaseToWriter(buff, "DeclarationTest", "0.0.1")
+	assert.NoError(t, err)
+	loaded, err := ast.NewKnowledgeLibrary().LoadKnowledgeBaseFromReader(buff, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Zulu", "Alpha", "Mike"}, ruleNames(loaded.OrderedRuleEntries()))
+	assert.Equal(t, mike, loaded.RuleEntries["Mike"].Declaration)
+
+	// rules with the same salience are executed in declaration order.
+	for i := 0; i < 5; i++ {
+		instance, err := lib.NewKnowledgeBaseInstance("DeclarationTest", "0.0.1")
+		assert.NoError(t, err)
+		fact := &DeclarationFact{}
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Fact", fact)
+		assert.NoError(t, err)
+		err = engine.NewGruleEngine().Execute(dctx, instance)
+		assert.NoError(t, err)
+		assert.Equal(t, "Zulu,Alpha,Mike,", fact.Trail)
+	}
+}
@@ -0,0 +1,252 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type DryRunOrder struct {
+	Amount int64
+	Status string
+	Count  int64
+	Seen   bool
+	Tags   map[string]string
+	Items  []string
+}
+
+func (o *DryRunOrder) MarkSeen() {
+	o.Seen = true
+}
+
+const dryRunGRL = `
+rule Big "big order" salience 10 {
+	when
+		Order.Amount > 100 && Order.Status == "new"
+	then
+		Order.Status = "big";
+		Order.Tags["size"] = "large";
+		Order.Items.Append("gift");
+		Order.MarkSeen();
+}
+
+rule Count "count the items" {
+	when
+		Order.Status == "big" && Order.Count == 0
+	then
+		Order.Count = Order.Items.Len();
+		Retract("Count");
+}
+
+rule Json "json order" {
+	when
+		Json.status == "new"
+	then
+		Json.status = "checked";
+		Retract("Json");
+}
+`
+
+func newDryRunOrder() *DryRunOrder {
+
+	return &DryRunOrder{
+		Amount: 150,
+		Status: "new",
+		Tags:   map[string]string{"color": "red"},
+		Items:  []string{"book"},
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("DryRunTest", "0.0.1", pkg.NewBytesResource([]byte(dryRunGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("DryRunTest", "0.0.1")
+	assert.NoError(t, err)
+
+	order := newDryRunOrder()
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Order", order))
+	assert.NoError(t, dctx.AddJSON("Json", []byte(`{"status":"new"}`)))
+
+	eng := engine.NewGruleEngine()
+	changeSet, err := eng.DryRun(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+
+	// the facts are left untouched, even by the fact's own function.
+	assert.Equal(t, newDryRunOrder(), order)
+	status, err := dctx.Get("Json").GetObjectValueByField("status")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", status.String())
+
+	// the Count rule sees the changes made by the Big rule, the change MarkSeen made replaces the whole fact.
+	assert.Len(t, changeSet.Changes, 6)
+	paths := make([]string, len(changeSet.Changes))
+	for i, change := range changeSet.Changes {
+		paths[i] = change.Path
+	}
+	assert.Equal(t, []string{"Order.Status", `Order.Tags["size"]`, "Order.Items", "Order", "Order.Count", "Json.status"}, paths)
+	assert.Equal(t, "Big", changeSet.Changes[0].Rule)
+	assert.Equal(t, "new", changeSet.Changes[0].OldValue.String())
+	assert.Equal(t, "big", changeSet.Changes[0].NewValue.String())
+	assert.False(t, changeSet.Changes[1].OldValue.IsValid())
+	assert.Equal(t, "large", changeSet.Changes[1].NewValue.String())
+	assert.Equal(t, []string{"book"}, changeSet.Changes[2].OldValue.Interface())
+	assert.Equal(t, []string{"book", "gift"}, changeSet.Changes[2].NewValue.Interface())
+	assert.Equal(t, "Big", changeSet.Changes[3].Rule)
+	assert.False(t, changeSet.Changes[3].OldValue.Interface().(*DryRunOrder).Seen)
+	assert.True(t, changeSet.Changes[3].NewValue.Interface().(*DryRunOrder).Seen)
+	assert.Equal(t, "Count", changeSet.Changes[4].Rule)
+	assert.Equal(t, int64(2), changeSet.Changes[4].NewValue.Int())
+	assert.Equal(t, "Json", changeSet.Changes[5].Rule)
+
+	// applying the change set makes the same changes as the execution, in the facts given to the data context.
+	assert.NoError(t, changeSet.Apply(dctx))
+	assert.Same(t, order, dctx.Get("Order").Value().Interface())
+	executed := newDryRunOrder()
+	executedCtx := ast.NewDataContext()
+	assert.NoError(t, executedCtx.Add("Order", executed))
+	assert.NoError(t, executedCtx.AddJSON("Json", []byte(`{"status":"new"}`)))
+	assert.NoError(t, eng.Execute(executedCtx, kb))
+	assert.Equal(t, executed, order)
+	status, err = dctx.Get("Json").GetObjectValueByField("status")
+	assert.NoError(t, err)
+	assert.Equal(t, "checked", status.String())
+
+	_, err = eng.DryRun(context.Background(), nil, kb)
+	assert.Error(t, err)
+}
+
+func TestDryRunWrapped(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("DryRunTest", "0.0.1", pkg.NewBytesResource([]byte(dryRunGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("DryRunTest", "0.0.1")
+	assert.NoError(t, err)
+
+	order := newDryRunOrder()
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Order", order))
+	assert.NoError(t, dctx.AddJSON("Json", []byte(`{"status":"new"}`)))
+
+	// a data context wrapping the dry run one hands it the changes, so the facts are still left untouched.
+	dryRunCtx := ast.NewDryRunDataContext(dctx)
+	transaction := ast.NewTransactionDataContext(dryRunCtx)
+	assert.NoError(t, engine.NewGruleEngine().Execute(transaction, kb))
+	assert.Equal(t, newDryRunOrder(), order)
+	assert.Len(t, dryRunCtx.ChangeSet().Changes, 6)
+	assert.Equal(t, 0, transaction.Changes())
+}
+
+func TestDryRunWhenScopeCalls(t *testing.T) {
+	grl := `
+rule Json "json order with one item" {
+	when
+		Order.Items.Len() == 1 && Json.status == "new"
+	then
+		Json.status = "checked";
+		Retract("Json");
+}
+`
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("DryRunTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("DryRunTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Order", newDryRunOrder()))
+	assert.NoError(t, dctx.AddJSON("Json", []byte(`{"status":"new"}`)))
+
+	// the function called by the when scope is not intercepted, so the order is never copied.
+	dryRunCtx := ast.NewDryRunDataContext(dctx)
+	assert.NoError(t, engine.NewGruleEngine().Execute(dryRunCtx, kb))
+	assert.Nil(t, dryRunCtx.DataContext.Get("Order"))
+	assert.NotNil(t, dryRunCtx.DataContext.Get("Json"))
+	assert.Len(t, dryRunCtx.ChangeSet().Changes, 1)
+	assert.Equal(t, "Json.status", dryRunCtx.ChangeSet().Changes[0].Path)
+}
+
+const dryRunFactsGRL = `
+rule Swap "swap the facts" {
+	when
+		F.Status == "new"
+	then
+		Insert("G", "inserted");
+		Modify("H", 2);
+		Delete("F");
+}
+
+rule Seen "the inserted fact is seen" {
+	when
+		G == "inserted"
+	then
+		Modify("G", "seen");
+}
+`
+
+func TestDryRunFacts(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("DryRunFacts", "0.0.1", pkg.NewBytesResource([]byte(dryRunFactsGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("DryRunFacts", "0.0.1")
+	assert.NoError(t, err)
+
+	order := &DryRunOrder{Status: "new"}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("F", order))
+	assert.NoError(t, dctx.Add("H", 1))
+
+	changeSet, err := engine.NewGruleEngine().DryRun(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+
+	// the facts are left untouched.
+	assert.Nil(t, dctx.Get("G"))
+	assert.False(t, dctx.IsRetracted("F"))
+	assert.Equal(t, int64(1), dctx.Get("H").Value().Int())
+
+	assert.Len(t, changeSet.Changes, 4)
+	inserted, modified, deleted, seen := changeSet.Changes[0], changeSet.Changes[1], changeSet.Changes[2], changeSet.Changes[3]
+	assert.Equal(t, "G", inserted.Path)
+	assert.False(t, inserted.OldValue.IsValid())
+	assert.Equal(t, "inserted", inserted.NewValue.String())
+	assert.Equal(t, "H", modified.Path)
+	assert.Equal(t, int64(1), modified.OldValue.Int())
+	assert.Equal(t, int64(2), modified.NewValue.Int())
+	assert.Equal(t, "F", deleted.Path)
+	assert.True(t, deleted.Deleted)
+	assert.Same(t, order, deleted.OldValue.Interface())
+	assert.False(t, deleted.NewValue.IsValid())
+	assert.Equal(t, "Seen", seen.Rule)
+	assert.Equal(t, "seen", seen.NewValue.String())
+
+	// applying the change set inserts, replaces and deletes the facts.
+	assert.NoError(t, changeSet.Apply(dctx))
+	assert.Equal(t, "seen", dctx.Get("G").Value().String())
+	assert.Equal(t, int64(2), dctx.Get("H").Value().Int())
+	assert.True(t, dctx.IsRetracted("F"))
+
+	// a fact already retracted stays retracted in the dry run, so the rules referring to it are not candidates.
+	changeSet, err = engine.NewGruleEngine().DryRun(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+	assert.Empty(t, changeSet.Changes)
+}
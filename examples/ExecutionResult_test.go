@@ -0,0 +1,103 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const resultGRL = `
+rule Increment "count to three" salience 10 {
+	when
+		Fact.Count < 3
+	then
+		Fact.Count = Fact.Count + 1;
+}
+
+rule Stop "complete the execution" {
+	when
+		Fact.Count == 3 && Fact.Other == 1
+	then
+		Complete();
+}
+
+rule Forever "never stops" {
+	when
+		Fact.Other == 2
+	then
+		Fact.Trail = "forever";
+}
+`
+
+func executeWithResult(t *testing.T, ctx context.Context, eng *engine.GruleEngine, fact *AttributeFact) (*engine.ExecutionResult, error) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ResultTest", "0.0.1", pkg.NewBytesResource([]byte(resultGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ResultTest", "0.0.1")
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	return eng.ExecuteWithResult(ctx, dctx, kb)
+}
+
+func TestExecuteWithResult(t *testing.T) {
+	result, err := executeWithResult(t, context.Background(), engine.NewGruleEngine(), &AttributeFact{})
+	assert.NoError(t, err)
+	assert.Equal(t, "ResultTest", result.KnowledgeBase)
+	assert.Equal(t, uint64(3), result.Cycles)
+	assert.Equal(t, engine.StopNoMoreRule, result.StopReason)
+	assert.Equal(t, []engine.FiredRule{
+		{RuleName: "Increment", Cycle: 1},
+		{RuleName: "Increment", Cycle: 2},
+		{RuleName: "Increment", Cycle: 3},
+	}, result.FiredRules)
+	assert.Equal(t, map[string]int{"Increment": 3}, result.FireCounts)
+	assert.True(t, result.Duration > 0)
+	assert.NoError(t, result.Err)
+
+	result, err = executeWithResult(t, context.Background(), engine.NewGruleEngine(), &AttributeFact{Other: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, engine.StopCompleted, result.StopReason)
+	assert.Equal(t, uint64(4), result.Cycles)
+	assert.Equal(t, map[string]int{"Increment": 3, "Stop": 1}, result.FireCounts)
+
+	eng := engine.NewGruleEngine()
+	eng.MaxCycle = 10
+	result, err = executeWithResult(t, context.Background(), eng, &AttributeFact{Count: 3, Other: 2})
+	assert.Error(t, err)
+	assert.Equal(t, engine.StopMaxCycle, result.StopReason)
+	assert.Equal(t, err, result.Err)
+	assert.Equal(t, 10, result.FireCounts["Forever"])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err = executeWithResult(t, ctx, engine.NewGruleEngine(), &AttributeFact{})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, engine.StopCanceled, result.StopReason)
+	assert.Empty(t, result.FiredRules)
+
+	_, err = engine.NewGruleEngine().ExecuteWithResult(context.Background(), ast.NewDataContext(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,158 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const traceGRL = `
+rule Start "start counting" salience 10 {
+	when
+		Fact.Count == 0 && Fact.Trail == ""
+	then
+		Fact.Count = 1;
+		Fact.Trail = "Start";
+}
+
+rule Next "count again" salience 5 {
+	when
+		Fact.Count > 0 && Fact.Count < 2
+	then
+		Fact.Count += 1;
+		Fact.Trail = Fact.Trail + ",Next";
+}
+
+rule Other "runs first and last" {
+	when
+		Fact.Other == 0 && (Fact.Count == 2 || Fact.Count < 1)
+	then
+		Fact.Other = 1;
+}
+`
+
+func TestExecuteWithTrace(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("TraceTest", "0.0.1", pkg.NewBytesResource([]byte(traceGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("TraceTest", "0.0.1")
+	assert.NoError(t, err)
+
+	fact := &AttributeFact{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	eng := engine.NewGruleEngine()
+	trace, err := eng.ExecuteWithTrace(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, "Start,Next", fact.Trail)
+	assert.Equal(t, "TraceTest", trace.KnowledgeBase)
+	assert.Equal(t, "0.0.1", trace.Version)
+	assert.Len(t, trace.Cycles, 3)
+
+	first := trace.Cycles[0]
+	assert.Equal(t, uint64(1), first.Cycle)
+	assert.Equal(t, ast.MainAgendaGroup, first.AgendaGroup)
+	assert.Equal(t, "Start", first.Fired)
+	assert.Len(t, first.Candidates, 2)
+	assert.Equal(t, "Start", first.Candidates[0].RuleName)
+	assert.Equal(t, "Other", first.Candidates[1].RuleName)
+	assert.Equal(t, "highest salience 10, next candidate Other has salience 0", first.Reason)
+	assert.Equal(t, "Fact.Count==0&&Fact.Trail==\"\"", first.Conditions[0].Expression)
+	assert.Equal(t, true, first.Conditions[0].Value)
+	assert.Equal(t, []*engine.AssignmentTrace{
+		{Variable: "Fact.Count", OldValue: 0, NewValue: 1},
+		{Variable: "Fact.Trail", OldValue: "", NewValue: "Start"},
+	}, first.Assignments)
+
+	second := trace.Cycles[1]
+	assert.Equal(t, "Next", second.Fired)
+	assert.Equal(t, []*engine.AssignmentTrace{
+		{Variable: "Fact.Count", OldValue: 1, NewValue: 2},
+		{Variable: "Fact.Trail", OldValue: "Start", NewValue: "Start,Next"},
+	}, second.Assignments)
+
+	// the right side of the short-circuited || is not evaluated.
+	third := trace.Cycles[2]
+	assert.Equal(t, "Other", third.Fired)
+	assert.Equal(t, "only candidate", third.Reason)
+	evaluated := make(map[string]bool)
+	for _, condition := range third.Conditions {
+		evaluated[condition.Expression] = condition.Evaluated
+	}
+	assert.True(t, evaluated["Fact.Count==2"])
+	assert.False(t, evaluated["Fact.Count<1"])
+	assert.Equal(t, []*engine.AssignmentTrace{
+		{Variable: "Fact.Other", OldValue: 0, NewValue: 1},
+	}, third.Assignments)
+
+	data, err := json.Marshal(trace)
+	assert.NoError(t, err)
+	decoded := &engine.ExecutionTrace{}
+	err = json.Unmarshal(data, decoded)
+	assert.NoError(t, err)
+	assert.Len(t, decoded.Cycles, 3)
+	assert.Equal(t, "Next", decoded.Cycles[1].Fired)
+}
+
+func TestExecuteWithTraceIsOptIn(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("TraceTest", "0.0.1", pkg.NewBytesResource([]byte(traceGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("TraceTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", &AttributeFact{})
+	assert.NoError(t, err)
+	_, err = engine.NewGruleEngine().ExecuteWithTrace(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+	// the observer is removed once the traced execution is over.
+	assert.Nil(t, dctx.(ast.ObservableDataContext).GetObserver())
+}
+
+// plainDataContext is an IDataContext that can't hold an ExecutionObserver.
+type plainDataContext struct {
+	ast.IDataContext
+}
+
+func TestExecuteWithTraceUnobservableDataContext(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("TraceTest", "0.0.1", pkg.NewBytesResource([]byte(traceGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("TraceTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", &AttributeFact{})
+	assert.NoError(t, err)
+	// the executed rule entries are traced, but not the assignments made through the data context.
+	trace, err := engine.NewGruleEngine().ExecuteWithTrace(context.Background(), &plainDataContext{IDataContext: dctx}, kb)
+	assert.NoError(t, err)
+	assert.Len(t, trace.Cycles, 3)
+	assert.Empty(t, trace.Cycles[2].Assignments)
+}
@@ -0,0 +1,163 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const extendedListenerGRL = `
+rule Double "double the amount" salience 10 {
+	when
+		Order.Amount < 100
+	then
+		Order.Amount = Order.Amount * 2;
+}
+
+rule Done "retract the rules" {
+	when
+		Order.Amount >= 100
+	then
+		Order.Total = Order.Double();
+		Retract("Double");
+		Retract("Done");
+}
+`
+
+// ListenerOrder is a fact with a method called from the rules.
+type ListenerOrder struct {
+	Amount int
+	Total  int
+}
+
+// Double returns twice the amount.
+func (o *ListenerOrder) Double() int {
+
+	return o.Amount * 2
+}
+
+// ExtendedEventListener records the extended events as text.
+type ExtendedEventListener struct {
+	Events []string
+	Result *engine.ExecutionResult
+}
+
+// EvaluateRuleEntry is not used by this listener.
+func (l *ExtendedEventListener) EvaluateRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry, candidate bool) {
+}
+
+// ExecuteRuleEntry is not used by this listener.
+func (l *ExtendedEventListener) ExecuteRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry) {
+}
+
+// BeginCycle is not used by this listener.
+func (l *ExtendedEventListener) BeginCycle(ctx context.Context, cycle uint64) {
+}
+
+// VariableAssigned records the assignment.
+func (l *ExtendedEventListener) VariableAssigned(ctx context.Context, cycle uint64, entry *ast.RuleEntry, variable string, oldValue, newValue reflect.Value) {
+	l.Events = append(l.Events, fmt.Sprintf("%d %s assigned %s %v -> %v", cycle, entry.RuleName, variable, oldValue, newValue))
+}
+
+// FunctionCalled records the method calls, the built-in functions are ignored.
+func (l *ExtendedEventListener) FunctionCalled(ctx context.Context, cycle uint64, entry *ast.RuleEntry, call *engine.FunctionCall) {
+	if call.Name == "Order.Double" {
+		l.Events = append(l.Events, fmt.Sprintf("%d %s called %s = %v", cycle, entry.RuleName, call.Name, call.Result))
+	}
+}
+
+// RuleRetracted records the retraction.
+func (l *ExtendedEventListener) RuleRetracted(ctx context.Context, cycle uint64, entry *ast.RuleEntry, ruleName string) {
+	l.Events = append(l.Events, fmt.Sprintf("%d %s retracted %s", cycle, entry.RuleName, ruleName))
+}
+
+// RuleEntryFailed records the failure.
+func (l *ExtendedEventListener) RuleEntryFailed(ctx context.Context, cycle uint64, entry *ast.RuleEntry, err error) {
+	l.Events = append(l.Events, fmt.Sprintf("%d %s failed", cycle, entry.RuleName))
+}
+
+// ExecutionFinished keeps the result.
+func (l *ExtendedEventListener) ExecutionFinished(ctx context.Context, result *engine.ExecutionResult) {
+	l.Result = result
+}
+
+func TestExtendedListener(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ListenerTest", "0.0.1", pkg.NewBytesResource([]byte(extendedListenerGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ListenerTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Order", &ListenerOrder{Amount: 30})
+	assert.NoError(t, err)
+
+	listener := &ExtendedEventListener{}
+	eng := engine.NewGruleEngine()
+	eng.Listeners = []engine.GruleEngineListener{listener, &AnnotationAuditListener{}}
+	err = eng.Execute(dctx, kb)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"1 Double assigned Order.Amount 30 -> 60",
+		"2 Double assigned Order.Amount 60 -> 120",
+		"3 Done called Order.Double = 240",
+		"3 Done assigned Order.Total 0 -> 240",
+		"3 Done retracted Double",
+		"3 Done retracted Done",
+	}, listener.Events)
+	assert.Equal(t, uint64(3), listener.Result.Cycles)
+	assert.Equal(t, engine.StopNoMoreRule, listener.Result.StopReason)
+	assert.Equal(t, "ListenerTest", listener.Result.KnowledgeBase)
+	assert.NoError(t, listener.Result.Err)
+}
+
+func TestExtendedListenerMaxCycle(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ListenerTest", "0.0.1", pkg.NewBytesResource([]byte(`
+rule Forever "never stops" {
+	when
+		Order.Amount > 0
+	then
+		Order.Amount = 1;
+}`)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ListenerTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Order", &ListenerOrder{Amount: 1})
+	assert.NoError(t, err)
+
+	listener := &ExtendedEventListener{}
+	eng := engine.NewGruleEngine()
+	eng.MaxCycle = 5
+	eng.Listeners = []engine.GruleEngineListener{listener}
+	err = eng.Execute(dctx, kb)
+	assert.Error(t, err)
+	assert.Equal(t, engine.StopMaxCycle, listener.Result.StopReason)
+	assert.Equal(t, uint64(5), listener.Result.Cycles)
+	assert.Equal(t, err, listener.Result.Err)
+}
@@ -0,0 +1,146 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type InsertionOrder struct {
+	Total float64
+	Paid  float64
+}
+
+const factInsertionGRL = `
+rule AddDiscount "derive the discount of large orders" {
+	when
+		Order.Total > 100 && Order.Paid == 0
+	then
+		Insert("Discount", Order.Total / 10);
+		Retract("AddDiscount");
+}
+
+rule CapDiscount "the discount is 5 at most" salience 10 {
+	when
+		Discount > 5
+	then
+		Modify("Discount", 5);
+}
+
+rule ApplyDiscount "pay the discounted total" {
+	when
+		Discount > 0 && Order.Paid == 0
+	then
+		Order.Paid = Order.Total - Discount;
+		Delete("Discount");
+}
+
+rule PayInFull "pay the total of small orders" {
+	when
+		Order.Total <= 100 && Order.Paid == 0
+	then
+		Order.Paid = Order.Total;
+}
+`
+
+func executeFactInsertion(t *testing.T, eng *engine.GruleEngine, grl string, order *InsertionOrder) (ast.IDataContext, error) {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("InsertionTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("InsertionTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Order", order))
+	// the discount is retracted until a rule inserts it, so the rules referring to it are not candidates.
+	dctx.Retract("Discount")
+
+	return dctx, eng.ExecuteWithContext(context.Background(), dctx, kb)
+}
+
+func TestInsertModifyDelete(t *testing.T) {
+	eng := engine.NewGruleEngine()
+	eng.ReturnErrOnFailedRuleEvaluation = true
+
+	order := &InsertionOrder{Total: 200}
+	dctx, err := executeFactInsertion(t, eng, factInsertionGRL, order)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(195), order.Paid)
+	assert.True(t, dctx.IsRetracted("Discount"))
+
+	order = &InsertionOrder{Total: 30}
+	dctx, err = executeFactInsertion(t, eng, factInsertionGRL, order)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(30), order.Paid)
+	assert.True(t, dctx.IsRetracted("Discount"))
+}
+
+func TestInsertModifyDeleteErrors(t *testing.T) {
+	eng := engine.NewGruleEngine()
+
+	_, err := executeFactInsertion(t, eng, `
+rule InsertTwice "insert an existing fact" {
+	when
+		Order.Paid == 0
+	then
+		Insert("Order", 1);
+}`, &InsertionOrder{})
+	assert.ErrorContains(t, err, "use Modify")
+
+	_, err = executeFactInsertion(t, eng, `
+rule ModifyMissing "modify a retracted fact" {
+	when
+		Order.Paid == 0
+	then
+		Modify("Discount", 1);
+}`, &InsertionOrder{})
+	assert.ErrorContains(t, err, "use Insert")
+
+	_, err = executeFactInsertion(t, eng, `
+rule DeleteFunctions "delete the built-in functions" {
+	when
+		Order.Paid == 0
+	then
+		Delete("DEFUNC");
+}`, &InsertionOrder{})
+	assert.Error(t, err)
+}
+
+func TestInsertDeleteRollback(t *testing.T) {
+	eng := engine.NewGruleEngine()
+	eng.RollbackOnFailure = true
+
+	dctx, err := executeFactInsertion(t, eng, `
+rule Replace "insert a discount, delete the order then fail" {
+	when
+		Order.Paid == 0
+	then
+		Insert("Discount", 10);
+		Delete("Order");
+		Order.Missing = 1;
+}`, &InsertionOrder{})
+	assert.Error(t, err)
+	assert.True(t, dctx.IsRetracted("Discount"))
+	assert.Nil(t, dctx.Get("Discount"))
+	assert.False(t, dctx.IsRetracted("Order"))
+}
@@ -0,0 +1,247 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// buildTrailVersion builds a version of the HotTest knowledge base whose rule sets the fact's trail to the version.
+func buildTrailVersion(t *testing.T, version string) *ast.KnowledgeLibrary {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	grl := fmt.Sprintf(`rule Trail { when Fact.Trail == "" then Fact.Trail = "%s"; }`, version)
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("HotTest", version, pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+
+	return lib
+}
+
+func executeTrail(t *testing.T, kb *ast.KnowledgeBase) string {
+	t.Helper()
+	fact := &AttributeFact{}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Fact", fact))
+	assert.NoError(t, engine.NewGruleEngine().Execute(dctx, kb))
+
+	return fact.Trail
+}
+
+func TestHotKnowledgeLibrary(t *testing.T) {
+	lib := ast.NewHotKnowledgeLibrary()
+	changes := make([]ast.KnowledgeBaseChange, 0)
+	lib.AddListener(func(change ast.KnowledgeBaseChange) {
+		changes = append(changes, change)
+	})
+	_, err := lib.NewSession("HotTest")
+	assert.Error(t, err)
+	_, err = lib.Rollback("HotTest")
+	assert.Error(t, err)
+	assert.Error(t, lib.Publish(buildTrailVersion(t, "1"), "HotTest", "2"))
+
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "1"), "HotTest", "1"))
+	first, err := lib.NewSession("HotTest")
+	assert.NoError(t, err)
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "2"), "HotTest", "2"))
+	second, err := lib.NewSession("HotTest")
+	assert.NoError(t, err)
+	version, ok := lib.ActiveVersion("HotTest")
+	assert.True(t, ok)
+	assert.Equal(t, "2", version)
+
+	// the session keeps the version it was created from.
+	assert.Equal(t, "1", executeTrail(t, first))
+	assert.Equal(t, "2", executeTrail(t, second))
+
+	assert.Error(t, lib.Publish(buildTrailVersion(t, "2"), "HotTest", "2"))
+	assert.Error(t, lib.Activate("HotTest", "3"))
+	version, err = lib.Rollback("HotTest")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", version)
+	_, err = lib.Rollback("HotTest")
+	assert.Error(t, err)
+	assert.NoError(t, lib.Activate("HotTest", "2"))
+	assert.NoError(t, lib.Activate("HotTest", "2"))
+	assert.Equal(t, []string{"1", "2"}, lib.Versions("HotTest"))
+	kb, err := lib.GetKnowledgeBaseVersion("HotTest", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", executeTrail(t, kb.NewSession()))
+
+	assert.Equal(t, []ast.KnowledgeBaseChange{
+		{Kind: ast.KnowledgeBasePublished, Name: "HotTest", Version: "1"},
+		{Kind: ast.KnowledgeBasePublished, Name: "HotTest", PreviousVersion: "1", Version: "2"},
+		{Kind: ast.KnowledgeBaseRolledBack, Name: "HotTest", PreviousVersion: "2", Version: "1"},
+		{Kind: ast.KnowledgeBaseActivated, Name: "HotTest", PreviousVersion: "1", Version: "2"},
+	}, changes)
+}
+
+func TestHotKnowledgeLibraryReload(t *testing.T) {
+	lib := ast.NewHotKnowledgeLibrary()
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "0"), "HotTest", "0"))
+	libs := make([]*ast.KnowledgeLibrary, 10)
+	for i := range libs {
+		libs[i] = buildTrailVersion(t, fmt.Sprint(i+1))
+	}
+
+	// the executions run while the new versions are published, each one sees a single version.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				version, _ := lib.ActiveVersion("HotTest")
+				kb, err := lib.NewSession("HotTest")
+				assert.NoError(t, err)
+				activeVersion, _ := strconv.Atoi(version)
+				trail, err := strconv.Atoi(executeTrail(t, kb))
+				assert.NoError(t, err)
+				assert.GreaterOrEqual(t, trail, activeVersion)
+			}
+		}()
+	}
+	for i, versionLib := range libs {
+		assert.NoError(t, lib.Publish(versionLib, "HotTest", fmt.Sprint(i+1)))
+	}
+	wg.Wait()
+	kb, err := lib.NewSession("HotTest")
+	assert.NoError(t, err)
+	assert.Equal(t, "10", executeTrail(t, kb))
+}
+
+func TestHotKnowledgeLibraryListenerReads(t *testing.T) {
+	lib := ast.NewHotKnowledgeLibrary()
+	changes := make([]ast.KnowledgeBaseChange, 0)
+	lib.AddListener(func(change ast.KnowledgeBaseChange) {
+		// the library can be read while the listeners are notified, even while another version is being published.
+		version, ok := lib.ActiveVersion(change.Name)
+		assert.True(t, ok)
+		assert.NotEmpty(t, version)
+		kb, err := lib.GetKnowledgeBase(change.Name)
+		assert.NoError(t, err)
+		assert.NotNil(t, kb)
+		changes = append(changes, change)
+	})
+	libs := make([]*ast.KnowledgeLibrary, 20)
+	for i := range libs {
+		libs[i] = buildTrailVersion(t, fmt.Sprint(i+1))
+	}
+
+	done := make(chan bool)
+	go func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := i; j < len(libs); j += 4 {
+					assert.NoError(t, lib.Publish(libs[j], "HotTest", fmt.Sprint(j+1)))
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("publishing while the listener reads the library is deadlocked")
+	}
+
+	// the listeners are notified of every change, in the order of the changes.
+	assert.Len(t, changes, len(libs))
+	for i := 1; i < len(changes); i++ {
+		assert.Equal(t, changes[i-1].Version, changes[i].PreviousVersion)
+	}
+	version, _ := lib.ActiveVersion("HotTest")
+	assert.Equal(t, changes[len(changes)-1].Version, version)
+}
+
+func TestHotKnowledgeLibraryListenerChanges(t *testing.T) {
+	lib := ast.NewHotKnowledgeLibrary()
+	changes := make([]ast.KnowledgeBaseChange, 0)
+	lib.AddListener(func(change ast.KnowledgeBaseChange) {
+		changes = append(changes, change)
+		// a change made by a listener is notified once the listeners returned.
+		if change.Version == "2" {
+			_, err := lib.Rollback("HotTest")
+			assert.NoError(t, err)
+			assert.Len(t, changes, 2)
+		}
+	})
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "1"), "HotTest", "1"))
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "2"), "HotTest", "2"))
+	assert.Equal(t, []ast.KnowledgeBaseChange{
+		{Kind: ast.KnowledgeBasePublished, Name: "HotTest", Version: "1"},
+		{Kind: ast.KnowledgeBasePublished, Name: "HotTest", PreviousVersion: "1", Version: "2"},
+		{Kind: ast.KnowledgeBaseRolledBack, Name: "HotTest", PreviousVersion: "2", Version: "1"},
+	}, changes)
+}
+
+func TestHotKnowledgeLibraryListenerPanics(t *testing.T) {
+	lib := ast.NewHotKnowledgeLibrary()
+	versions := make([]string, 0)
+	lib.AddListener(func(change ast.KnowledgeBaseChange) {
+		panic("listener failure")
+	})
+	lib.AddListener(func(change ast.KnowledgeBaseChange) {
+		versions = append(versions, change.Version)
+	})
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "1"), "HotTest", "1"))
+	// the panic doesn't keep the next changes pending.
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "2"), "HotTest", "2"))
+	_, err := lib.Rollback("HotTest")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "1"}, versions)
+}
+
+func TestHotKnowledgeLibraryUnpublish(t *testing.T) {
+	lib := ast.NewHotKnowledgeLibrary()
+	// the version 1 was active before and after the version 2.
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "1"), "HotTest", "1"))
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "2"), "HotTest", "2"))
+	assert.NoError(t, lib.Activate("HotTest", "1"))
+	assert.NoError(t, lib.Publish(buildTrailVersion(t, "3"), "HotTest", "3"))
+	unpublished, err := lib.GetKnowledgeBaseVersion("HotTest", "2")
+	assert.NoError(t, err)
+
+	assert.Error(t, lib.Unpublish("HotTest", "3"))
+	assert.Error(t, lib.Unpublish("HotTest", "4"))
+	assert.NoError(t, lib.Unpublish("HotTest", "2"))
+	assert.Error(t, lib.Unpublish("HotTest", "2"))
+	assert.Equal(t, []string{"1", "3"}, lib.Versions("HotTest"))
+	_, err = lib.GetKnowledgeBaseVersion("HotTest", "2")
+	assert.Error(t, err)
+	assert.Error(t, lib.Activate("HotTest", "2"))
+	// the knowledge base of the unpublished version can still be executed.
+	assert.Equal(t, "2", executeTrail(t, unpublished.NewSession()))
+
+	// the rollbacks skip the unpublished version, and the version 1 only once.
+	version, err := lib.Rollback("HotTest")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", version)
+	_, err = lib.Rollback("HotTest")
+	assert.Error(t, err)
+}
@@ -0,0 +1,241 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+const diffNewGRL = `
+rule Small "small counts" salience 10 {
+	when
+		Fact.Count < 10 &&   Fact.Trail == ""
+	then
+		Fact.Trail = "small";
+		Fact.Count = Fact.Count + 1;
+		Retract("Small");
+}
+
+rule Medium "medium count" {
+	when
+		Fact.Count >= 10 && Fact.Count < 20
+	then
+		Fact.Trail = "medium";
+		Retract("Medium");
+}
+`
+
+func TestDiffKnowledgeBases(t *testing.T) {
+	oldKB := buildAttributeKnowledgeBase(t, batchGRL).GetKnowledgeBase("AttributeTest", "0.0.1")
+	newKB := buildAttributeKnowledgeBase(t, diffNewGRL).GetKnowledgeBase("AttributeTest", "0.0.1")
+
+	assert.True(t, ast.DiffKnowledgeBases(oldKB, oldKB).IsEmpty())
+
+	diff := ast.DiffKnowledgeBases(oldKB, newKB)
+	assert.Equal(t, []string{"Medium"}, diff.Added)
+	assert.Equal(t, []string{"Large"}, diff.Removed)
+	assert.Len(t, diff.Modified, 1)
+
+	small := diff.Modified[0]
+	assert.Equal(t, "Small", small.RuleName)
+	assert.True(t, small.SalienceChanged)
+	assert.Equal(t, 0, small.OldSalience)
+	assert.Equal(t, 10, small.NewSalience)
+	assert.True(t, small.DescriptionChanged)
+	assert.Equal(t, "small count", small.OldDescription)
+	assert.Equal(t, "small counts", small.NewDescription)
+	// the when expression only differs by its formatting.
+	assert.False(t, small.WhenChanged)
+	assert.Equal(t, []ast.ThenChange{
+		{Kind: ast.ThenStatementAdded, Statement: "Fact.Count=Fact.Count+1"},
+	}, small.ThenChanges)
+
+	reverse := ast.DiffKnowledgeBases(newKB, oldKB)
+	assert.Equal(t, []ast.ThenChange{
+		{Kind: ast.ThenStatementRemoved, Statement: "Fact.Count=Fact.Count+1"},
+	}, reverse.Modified[0].ThenChanges)
+	assert.Contains(t, diff.String(), "~ rule Small\n    salience: 0 -> 10\n")
+}
+
+func TestDiffCatalogs(t *testing.T) {
+	oldLib := buildAttributeKnowledgeBase(t, batchGRL)
+	newLib := buildAttributeKnowledgeBase(t, `
+rule Small "small count" {
+	when
+		Fact.Count < 5 && Fact.Trail == ""
+	then
+		Fact.Trail = "small";
+		Retract("Small");
+}
+`)
+	oldBuffer := &bytes.Buffer{}
+	assert.NoError(t, oldLib.StoreKnowledgeBaseToWriter(oldBuffer, "AttributeTest", "0.0.1"))
+	newBuffer := &bytes.Buffer{}
+	assert.NoError(t, newLib.StoreKnowledgeBaseToWriter(newBuffer, "AttributeTest", "0.0.1"))
+
+	diff, err := ast.DiffCatalogs(oldBuffer, newBuffer)
+	assert.NoError(t, err)
+	assert.Empty(t, diff.Added)
+	assert.Equal(t, []string{"Large"}, diff.Removed)
+	assert.Len(t, diff.Modified, 1)
+	assert.True(t, diff.Modified[0].WhenChanged)
+	assert.Equal(t, `Fact.Count<10&&Fact.Trail==""`, diff.Modified[0].OldWhen)
+	assert.Equal(t, `Fact.Count<5&&Fact.Trail==""`, diff.Modified[0].NewWhen)
+	assert.False(t, diff.Modified[0].SalienceChanged)
+	assert.Empty(t, diff.Modified[0].ThenChanges)
+}
+
+func TestDiffRuleAttributes(t *testing.T) {
+	oldKB := buildAttributeKnowledgeBase(t, `
+@owner("risk-team")
+@ticket("RISK-1")
+rule Small "small count" no-loop true group "sizing" date-expires "2030-01-01" {
+	when
+		Fact.Count < 10
+	then
+		Fact.Trail = "small";
+}
+
+rule Same "same rule" activation-group "size" {
+	when
+		Fact.Count < 10
+	then
+		Fact.Trail = "same";
+}
+`).GetKnowledgeBase("AttributeTest", "0.0.1")
+	newKB := buildAttributeKnowledgeBase(t, `
+@owner("pricing-team")
+@reviewed("yes")
+rule Small "small count" enabled false lock-on-active true activation-group "size" date-effective "2025-01-01" {
+	when
+		Fact.Count < 10
+	then
+		Fact.Trail = "small";
+}
+
+rule Same "same rule" activation-group "size" {
+	when
+		Fact.Count < 10
+	then
+		Fact.Trail = "same";
+}
+`).GetKnowledgeBase("AttributeTest", "0.0.1")
+
+	// only the attributes and the annotations changed.
+	diff := ast.DiffKnowledgeBases(oldKB, newKB)
+	assert.Len(t, diff.Modified, 1)
+	small := diff.Modified[0]
+	assert.Equal(t, "Small", small.RuleName)
+	assert.False(t, small.SalienceChanged)
+	assert.False(t, small.DescriptionChanged)
+	assert.False(t, small.WhenChanged)
+	assert.Empty(t, small.ThenChanges)
+
+	assert.True(t, small.EnabledChanged)
+	assert.True(t, small.OldEnabled)
+	assert.False(t, small.NewEnabled)
+	assert.True(t, small.NoLoopChanged)
+	assert.True(t, small.LockOnActiveChanged)
+	assert.True(t, small.AgendaGroupChanged)
+	assert.Equal(t, "sizing", small.OldAgendaGroup)
+	assert.Equal(t, "", small.NewAgendaGroup)
+	assert.True(t, small.ActivationGroupChanged)
+	assert.Equal(t, "size", small.NewActivationGroup)
+	assert.True(t, small.DateEffectiveChanged)
+	assert.True(t, small.OldDateEffective.IsZero())
+	assert.True(t, small.DateExpiresChanged)
+	assert.True(t, small.NewDateExpires.IsZero())
+	assert.Equal(t, []ast.AnnotationChange{
+		{Key: "owner", OldValue: "risk-team", NewValue: "pricing-team"},
+		{Key: "reviewed", Added: true, NewValue: "yes"},
+		{Key: "ticket", Removed: true, OldValue: "RISK-1"},
+	}, small.AnnotationChanges)
+
+	report := diff.String()
+	assert.Contains(t, report, "    enabled: true -> false\n")
+	assert.Contains(t, report, "    no-loop: true -> false\n")
+	assert.Contains(t, report, "    lock-on-active: false -> true\n")
+	assert.Contains(t, report, "    group: \"sizing\" -> \"\"\n")
+	assert.Contains(t, report, "    activation-group: \"\" -> \"size\"\n")
+	assert.Contains(t, report, "    date-effective: \"\" -> \"2025-01-01T00:00:00")
+	assert.Contains(t, report, "    @owner: \"risk-team\" -> \"pricing-team\"\n")
+	assert.Contains(t, report, "    + @reviewed(\"yes\")\n")
+	assert.Contains(t, report, "    - @ticket(\"RISK-1\")\n")
+
+	// the attributes survive the catalog, so the stored knowledge bases compare the same way.
+	oldBuffer := &bytes.Buffer{}
+	assert.NoError(t, buildAttributeKnowledgeBase(t, `@owner("a") rule Small "small" no-loop true { when true then Retract("Small"); }`).StoreKnowledgeBaseToWriter(oldBuffer, "AttributeTest", "0.0.1"))
+	newBuffer := &bytes.Buffer{}
+	assert.NoError(t, buildAttributeKnowledgeBase(t, `@owner("b") rule Small "small" no-loop true { when true then Retract("Small"); }`).StoreKnowledgeBaseToWriter(newBuffer, "AttributeTest", "0.0.1"))
+	catalogDiff, err := ast.DiffCatalogs(oldBuffer, newBuffer)
+	assert.NoError(t, err)
+	assert.Len(t, catalogDiff.Modified, 1)
+	assert.False(t, catalogDiff.Modified[0].NoLoopChanged)
+	assert.Equal(t, []ast.AnnotationChange{{Key: "owner", OldValue: "a", NewValue: "b"}}, catalogDiff.Modified[0].AnnotationChanges)
+}
+
+func TestDiffQueries(t *testing.T) {
+	oldLib := buildAttributeKnowledgeBase(t, `
+query IsSmall(Fact) "small facts" {
+	Fact.Count < 10
+}
+
+query IsDone() {
+	Fact.Trail != ""
+}
+`)
+	newLib := buildAttributeKnowledgeBase(t, `
+query IsSmall(Fact, Other) "small facts" {
+	Fact.Count <   5
+}
+
+query IsLarge() {
+	Fact.Count >= 20
+}
+`)
+	oldKB := oldLib.GetKnowledgeBase("AttributeTest", "0.0.1")
+	newKB := newLib.GetKnowledgeBase("AttributeTest", "0.0.1")
+	assert.True(t, ast.DiffKnowledgeBases(oldKB, oldKB).IsEmpty())
+
+	diff := ast.DiffKnowledgeBases(oldKB, newKB)
+	assert.False(t, diff.IsEmpty())
+	assert.Empty(t, diff.Modified)
+	assert.Equal(t, []string{"IsLarge"}, diff.AddedQueries)
+	assert.Equal(t, []string{"IsDone"}, diff.RemovedQueries)
+	assert.Len(t, diff.ModifiedQueries, 1)
+	small := diff.ModifiedQueries[0]
+	assert.Equal(t, "IsSmall", small.QueryName)
+	assert.False(t, small.DescriptionChanged)
+	assert.True(t, small.ParametersChanged)
+	assert.Equal(t, []string{"Fact"}, small.OldParameters)
+	assert.Equal(t, []string{"Fact", "Other"}, small.NewParameters)
+	assert.True(t, small.ExpressionChanged)
+	assert.Equal(t, "Fact.Count<10", small.OldExpression)
+	assert.Equal(t, "Fact.Count<5", small.NewExpression)
+	assert.Equal(t, "+ query IsLarge\n- query IsDone\n~ query IsSmall\n    parameters: (Fact) -> (Fact, Other)\n    expression: Fact.Count<10 -> Fact.Count<5\n", diff.String())
+
+	// the queries survive the catalog.
+	oldBuffer := &bytes.Buffer{}
+	assert.NoError(t, oldLib.StoreKnowledgeBaseToWriter(oldBuffer, "AttributeTest", "0.0.1"))
+	newBuffer := &bytes.Buffer{}
+	assert.NoError(t, newLib.StoreKnowledgeBaseToWriter(newBuffer, "AttributeTest", "0.0.1"))
+	catalogDiff, err := ast.DiffCatalogs(oldBuffer, newBuffer)
+	assert.NoError(t, err)
+	assert.Equal(t, diff.String(), catalogDiff.String())
+}
@@ -0,0 +1,192 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type LogicalCustomer struct {
+	Spent    float64
+	Discount int
+	Refunded bool
+}
+
+const logicalInsertionGRL = `
+rule GoldStatus "big spenders are gold" salience 10 {
+	when
+		Customer.Spent > 1000
+	then
+		InsertLogical("Gold", true);
+		Retract("GoldStatus");
+}
+
+rule PlatinumStatus "gold customers are platinum too" salience 5 {
+	when
+		Gold
+	then
+		InsertLogical("Platinum", 1);
+		Retract("PlatinumStatus");
+}
+
+rule GoldDiscount "gold customers get a discount" salience 3 {
+	when
+		Gold && Customer.Discount == 0
+	then
+		Customer.Discount = 10;
+}
+
+rule Refund "refund part of the spending" {
+	when
+		Customer.Refunded == false
+	then
+		Customer.Spent = Customer.Spent - 500;
+		Customer.Refunded = true;
+}
+`
+
+func executeLogicalInsertion(t *testing.T, grl string, customer *LogicalCustomer) (ast.IDataContext, error) {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("LogicalTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("LogicalTest", "0.0.1")
+	assert.NoError(t, err)
+
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Customer", customer))
+	dctx.Retract("Gold")
+	dctx.Retract("Platinum")
+	eng := engine.NewGruleEngine()
+	eng.ReturnErrOnFailedRuleEvaluation = true
+
+	return dctx, eng.ExecuteWithContext(context.Background(), dctx, kb)
+}
+
+func TestInsertLogical(t *testing.T) {
+	// the refund breaks the gold status, so both derived facts are retracted.
+	customer := &LogicalCustomer{Spent: 1500}
+	dctx, err := executeLogicalInsertion(t, logicalInsertionGRL, customer)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1000), customer.Spent)
+	assert.Equal(t, 10, customer.Discount)
+	assert.True(t, dctx.IsRetracted("Gold"))
+	assert.True(t, dctx.IsRetracted("Platinum"))
+
+	// the gold status still holds after the refund.
+	customer = &LogicalCustomer{Spent: 3000}
+	dctx, err = executeLogicalInsertion(t, logicalInsertionGRL, customer)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, customer.Discount)
+	assert.False(t, dctx.IsRetracted("Gold"))
+	assert.True(t, dctx.Get("Gold").Value().Bool())
+	assert.False(t, dctx.IsRetracted("Platinum"))
+
+	// a small spender never gets the status.
+	customer = &LogicalCustomer{Spent: 100}
+	dctx, err = executeLogicalInsertion(t, logicalInsertionGRL, customer)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, customer.Discount)
+	assert.Nil(t, dctx.Get("Gold"))
+}
+
+func TestInsertLogicalExistingFact(t *testing.T) {
+	_, err := executeLogicalInsertion(t, `
+rule Replace "replace the customer" {
+	when
+		Customer.Refunded == false
+	then
+		InsertLogical("Customer", 1);
+}`, &LogicalCustomer{})
+	assert.ErrorContains(t, err, "use Modify")
+}
+
+// JustificationListener records the evaluations of a rule entry's when scope.
+type JustificationListener struct {
+	RuleName    string
+	Evaluations []string
+}
+
+// EvaluateRuleEntry records the evaluation if it's the rule entry's.
+func (l *JustificationListener) EvaluateRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry, candidate bool) {
+	if entry.RuleName == l.RuleName {
+		l.Evaluations = append(l.Evaluations, fmt.Sprintf("%d:%v", cycle, candidate))
+	}
+}
+
+// ExecuteRuleEntry is not used by this listener.
+func (l *JustificationListener) ExecuteRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry) {
+}
+
+// BeginCycle is not used by this listener.
+func (l *JustificationListener) BeginCycle(ctx context.Context, cycle uint64) {
+}
+
+func TestInsertLogicalActivation(t *testing.T) {
+	for _, useRete := range []bool{false, true} {
+		lib := ast.NewKnowledgeLibrary()
+		err := builder.NewRuleBuilder(lib).BuildRuleFromResource("LogicalTest", "0.0.1", pkg.NewBytesResource([]byte(logicalInsertionGRL)))
+		assert.NoError(t, err)
+		kb, err := lib.NewKnowledgeBaseInstance("LogicalTest", "0.0.1")
+		assert.NoError(t, err)
+
+		customer := &LogicalCustomer{Spent: 1500}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Customer", customer))
+		dctx.Retract("Gold")
+		dctx.Retract("Platinum")
+		listener := &JustificationListener{RuleName: "GoldStatus"}
+		eng := engine.NewGruleEngine()
+		eng.UseReteNetwork = useRete
+		eng.Listeners = append(eng.Listeners, listener)
+		assert.NoError(t, eng.Execute(dctx, kb))
+
+		// the retracted rule is still evaluated like any other rule while it justifies a fact, up to the refund.
+		if useRete {
+			// the matcher keeps the match until the refund changes the customer's spending.
+			assert.Equal(t, []string{"1:true", "5:false"}, listener.Evaluations)
+		} else {
+			assert.Equal(t, []string{"1:true", "2:true", "3:true", "4:true", "5:false"}, listener.Evaluations)
+		}
+		assert.True(t, dctx.IsRetracted("Gold"))
+		assert.True(t, dctx.IsRetracted("Platinum"))
+	}
+
+	// the facts are tied to the activations that inserted them.
+	customer := &LogicalCustomer{Spent: 3000}
+	dctx, err := executeLogicalInsertion(t, logicalInsertionGRL, customer)
+	assert.NoError(t, err)
+	justifications := dctx.Get("DEFUNC").Value().Interface().(*ast.BuiltInFunctions).Justifications
+	gold, ok := justifications.Get("Gold")
+	assert.True(t, ok)
+	assert.Equal(t, "GoldStatus", gold.RuleEntry.RuleName)
+	assert.Equal(t, uint64(1), gold.Cycle)
+	assert.Equal(t, uint64(1), gold.Sequence)
+	platinum, ok := justifications.Get("Platinum")
+	assert.True(t, ok)
+	assert.Equal(t, "PlatinumStatus", platinum.RuleEntry.RuleName)
+	assert.Equal(t, uint64(2), platinum.Cycle)
+	assert.Equal(t, uint64(3), platinum.Sequence)
+	_, ok = justifications.Activation()
+	assert.False(t, ok)
+}
@@ -0,0 +1,160 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type LoopFact struct {
+	Count int64
+	State string
+	Flag  bool
+}
+
+func (f *LoopFact) Noop() {
+}
+
+func executeLoopRules(t *testing.T, grl string, fact *LoopFact) (*engine.ExecutionResult, error) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("LoopTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("LoopTest", "0.0.1")
+	assert.NoError(t, err)
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Fact", fact))
+	eng := engine.NewGruleEngine()
+	eng.DetectLoops = true
+
+	return eng.ExecuteWithResult(context.Background(), dctx, kb)
+}
+
+func TestDetectLoops(t *testing.T) {
+	testData := []struct {
+		name      string
+		grl       string
+		rules     []string
+		variables []string
+	}{
+		{
+			name:  "no change",
+			grl:   `rule Idle { when Fact.Count < 10 then Fact.Noop(); }`,
+			rules: []string{"Idle"},
+		},
+		{
+			name:      "same value",
+			grl:       `rule Spin { when Fact.Count < 10 then Fact.Flag = true; }`,
+			rules:     []string{"Spin"},
+			variables: []string{"Fact.Flag"},
+		},
+		{
+			name: "ping pong",
+			grl: `rule Ping { when Fact.State == "ping" then Fact.State = "pong"; }
+rule Pong { when Fact.State == "pong" then Fact.State = "ping"; Fact.Flag = !Fact.Flag; Fact.Flag = !Fact.Flag; }`,
+			rules:     []string{"Pong", "Ping"},
+			variables: []string{"Fact.Flag", "Fact.State"},
+		},
+	}
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			result, err := executeLoopRules(t, data.grl, &LoopFact{State: "ping"})
+			var loop *engine.LoopError
+			assert.True(t, errors.As(err, &loop))
+			assert.Equal(t, engine.StopLoop, result.StopReason)
+			assert.Equal(t, result.Cycles, loop.Cycle)
+			assert.Less(t, result.Cycles, uint64(5))
+			assert.Equal(t, data.rules, loop.Rules)
+			if data.variables == nil {
+				assert.Empty(t, loop.Variables)
+				assert.Contains(t, err.Error(), "without changing the facts")
+			} else {
+				assert.Equal(t, data.variables, loop.Variables)
+			}
+		})
+	}
+
+	// the rule entries changing the facts each time are not looping.
+	fact := &LoopFact{}
+	result, err := executeLoopRules(t, `rule Count { when Fact.Count < 10 then Fact.Count = Fact.Count + 1; }`, fact)
+	assert.NoError(t, err)
+	assert.Equal(t, engine.StopNoMoreRule, result.StopReason)
+	assert.Equal(t, int64(10), fact.Count)
+}
+
+const focusStackLoopGRL = `
+rule M1 salience 10 {
+	when
+		!Fact.Flag
+	then
+		Fact.Flag = true;
+		SetFocus("G");
+		SetFocus("H");
+		SetFocus("G");
+}
+
+rule G1 no-loop group "G" {
+	when
+		Fact.Count >= 0
+	then
+		Fact.Count = 0;
+}
+
+rule H1 no-loop group "H" {
+	when
+		Fact.Count >= 0
+	then
+		Fact.Count = 1;
+}
+
+rule M2 {
+	when
+		Fact.Flag && Fact.State != "done"
+	then
+		Fact.State = "done";
+}
+`
+
+func TestDetectLoopsFocusStack(t *testing.T) {
+	// G1 is executed twice with the same facts, but the focus stack and the no-loop state differ.
+	for _, detectLoops := range []bool{false, true} {
+		lib := ast.NewKnowledgeLibrary()
+		err := builder.NewRuleBuilder(lib).BuildRuleFromResource("LoopTest", "0.0.1", pkg.NewBytesResource([]byte(focusStackLoopGRL)))
+		assert.NoError(t, err)
+		kb, err := lib.NewKnowledgeBaseInstance("LoopTest", "0.0.1")
+		assert.NoError(t, err)
+		fact := &LoopFact{}
+		dctx := ast.NewDataContext()
+		assert.NoError(t, dctx.Add("Fact", fact))
+		eng := engine.NewGruleEngine()
+		eng.DetectLoops = detectLoops
+		result, err := eng.ExecuteWithResult(context.Background(), dctx, kb)
+		assert.NoError(t, err)
+		assert.Equal(t, engine.StopNoMoreRule, result.StopReason)
+		names := make([]string, 0)
+		for _, fired := range result.FiredRules {
+			names = append(names, fired.RuleName)
+		}
+		assert.Equal(t, []string{"M1", "G1", "H1", "G1", "M2"}, names)
+		assert.Equal(t, "done", fact.State)
+	}
+}
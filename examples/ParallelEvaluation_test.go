@@ -0,0 +1,127 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// ParallelFact counts the calls to its Score method, which may be called concurrently.
+type ParallelFact struct {
+	Stage int
+	Trail string
+	calls int64
+}
+
+// Score returns a score for the level.
+func (f *ParallelFact) Score(level int64) int64 {
+	atomic.AddInt64(&f.calls, 1)
+
+	return level * 10
+}
+
+// parallelGRL makes many rule entries sharing the same sub-expressions, with a few candidates in each cycle.
+func parallelGRL(ruleCount int) string {
+	grl := &strings.Builder{}
+	for i := 0; i < ruleCount; i++ {
+		fmt.Fprintf(grl, `
+rule Rule%d "rule %d" salience %d {
+	when
+		Fact.Stage == %d && Fact.Score(%d) >= 0 && (Fact.Trail != "x" || Fact.Score(%d) > 100)
+	then
+		Fact.Trail = Fact.Trail + "%d,";
+		Fact.Stage = Fact.Stage + 1;
+}
+`, i, i, i%5, i%10, i%7, i%3, i)
+	}
+
+	return grl.String()
+}
+
+func executeParallelGRL(t *testing.T, grl string, workers int) (*ParallelFact, *engine.ExecutionResult) {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("ParallelTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("ParallelTest", "0.0.1")
+	assert.NoError(t, err)
+	fact := &ParallelFact{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+
+	eng := engine.NewGruleEngine()
+	eng.EvaluationWorkers = workers
+	result, err := eng.ExecuteWithResult(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+
+	return fact, result
+}
+
+func TestParallelEvaluation(t *testing.T) {
+	grl := parallelGRL(100)
+	expected, expectedResult := executeParallelGRL(t, grl, 0)
+	assert.Equal(t, 10, expected.Stage)
+	assert.Len(t, expectedResult.FiredRules, 10)
+
+	for _, workers := range []int{1, 2, 8, 500} {
+		for i := 0; i < 3; i++ {
+			fact, result := executeParallelGRL(t, grl, workers)
+			assert.Equal(t, expected.Trail, fact.Trail)
+			assert.Equal(t, expectedResult.FiredRules, result.FiredRules)
+			assert.Equal(t, expectedResult.Cycles, result.Cycles)
+			// the shared method calls are still evaluated once, then taken from the working memory.
+			assert.Equal(t, expected.calls, fact.calls)
+		}
+	}
+}
+
+func TestParallelEvaluationError(t *testing.T) {
+	grl := parallelGRL(20) + `
+rule Broken "broken" {
+	when
+		Fact.Missing == 1
+	then
+		Fact.Stage = 100;
+}
+`
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("ParallelTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	for _, workers := range []int{0, 4} {
+		kb, err := lib.NewKnowledgeBaseInstance("ParallelTest", "0.0.1")
+		assert.NoError(t, err)
+		dctx := ast.NewDataContext()
+		err = dctx.Add("Fact", &ParallelFact{})
+		assert.NoError(t, err)
+		eng := engine.NewGruleEngine()
+		eng.EvaluationWorkers = workers
+		eng.ReturnErrOnFailedRuleEvaluation = true
+		result, err := eng.ExecuteWithResult(context.Background(), dctx, kb)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Broken")
+		assert.Equal(t, engine.StopError, result.StopReason)
+	}
+}
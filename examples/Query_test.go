@@ -0,0 +1,242 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type QueryCustomer struct {
+	Age          int
+	Income       float64
+	Employed     bool
+	Senior       bool
+	Vip          bool
+	Adult        bool
+	CreditWorthy bool
+	Eligible     bool
+	Score        int
+}
+
+const queryGRL = `
+rule AdultRule "customers of 18 and over are adults" {
+	when
+		Customer.Age >= 18
+	then
+		Customer.Adult = true;
+}
+
+rule CreditRule "employed customers earning enough are credit worthy" {
+	when
+		Customer.Employed && Customer.Income >= 3000
+	then
+		Customer.CreditWorthy = true;
+}
+
+rule EligibleRule "adult and credit worthy customers are eligible" {
+	when
+		Customer.Adult && Customer.CreditWorthy
+	then
+		Customer.Eligible = true;
+}
+
+rule ScoreRule "every customer is scored" salience 10 {
+	when
+		Customer.Score == 0
+	then
+		Customer.Score = 100;
+}
+
+query IsEligible(Customer) "is the customer eligible" {
+	Customer.Eligible
+}
+
+query IsAdult() {
+	Customer.Adult == true
+}
+`
+
+func newQueryKnowledgeBase(t *testing.T) *ast.KnowledgeBase {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("QueryTest", "0.0.1", pkg.NewBytesResource([]byte(queryGRL)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("QueryTest", "0.0.1")
+	assert.NoError(t, err)
+
+	return kb
+}
+
+func TestQuery(t *testing.T) {
+	kb := newQueryKnowledgeBase(t)
+	assert.Len(t, kb.QueryEntries, 2)
+	query, ok := kb.GetQueryEntry("IsEligible")
+	assert.True(t, ok)
+	assert.Equal(t, "is the customer eligible", query.QueryDescription)
+	assert.Equal(t, []string{"Customer"}, query.Parameters)
+
+	customer := &QueryCustomer{Age: 30, Income: 5000, Employed: true}
+	result, err := engine.NewGruleEngine().Query(context.Background(), ast.NewDataContext(), kb, "IsEligible", customer)
+	assert.NoError(t, err)
+	assert.True(t, result.Answer)
+	assert.Equal(t, "IsEligible", result.Query)
+	assert.Equal(t, []string{"AdultRule", "CreditRule", "EligibleRule"}, result.RuleNames())
+
+	// the query doesn't change the facts, and the rule entries not needed to prove it are not executed.
+	assert.False(t, customer.Eligible)
+	assert.False(t, customer.Adult)
+	assert.Equal(t, 0, customer.Score)
+}
+
+func TestQueryNotProven(t *testing.T) {
+	kb := newQueryKnowledgeBase(t)
+	customer := &QueryCustomer{Age: 30, Income: 5000, Employed: false}
+	result, err := engine.NewGruleEngine().Query(context.Background(), ast.NewDataContext(), kb, "IsEligible", customer)
+	assert.NoError(t, err)
+	assert.False(t, result.Answer)
+	assert.Empty(t, result.Chain)
+}
+
+func TestQueryChainLeavesFailedSubgoals(t *testing.T) {
+	grl := queryGRL + `
+rule SeniorRule "customers of 65 and over are seniors" {
+	when
+		Customer.Age >= 65
+	then
+		Customer.Senior = true;
+}
+
+rule VipSeniorRule "vip seniors are eligible" salience 20 {
+	when
+		Customer.Senior && Customer.Vip
+	then
+		Customer.Eligible = true;
+}
+
+rule VipAdultRule "vip adults are eligible" salience 10 {
+	when
+		Customer.Adult && Customer.Vip
+	then
+		Customer.Eligible = true;
+}
+`
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("QueryChain", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("QueryChain", "0.0.1")
+	assert.NoError(t, err)
+
+	// SeniorRule and AdultRule are executed for the vip rules, which fail. EligibleRule doesn't need SeniorRule,
+	// but it needs the adult AdultRule already made.
+	customer := &QueryCustomer{Age: 70, Income: 5000, Employed: true}
+	result, err := engine.NewGruleEngine().Query(context.Background(), ast.NewDataContext(), kb, "IsEligible", customer)
+	assert.NoError(t, err)
+	assert.True(t, result.Answer)
+	assert.Equal(t, []string{"AdultRule", "CreditRule", "EligibleRule"}, result.RuleNames())
+}
+
+func TestQueryAlreadyHolds(t *testing.T) {
+	kb := newQueryKnowledgeBase(t)
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Customer", &QueryCustomer{Adult: true}))
+	result, err := engine.NewGruleEngine().Query(context.Background(), dctx, kb, "IsAdult")
+	assert.NoError(t, err)
+	assert.True(t, result.Answer)
+	assert.Empty(t, result.Chain)
+}
+
+func TestQueryErrors(t *testing.T) {
+	kb := newQueryKnowledgeBase(t)
+	eng := engine.NewGruleEngine()
+	customer := &QueryCustomer{Age: 30}
+
+	_, err := eng.Query(context.Background(), ast.NewDataContext(), kb, "IsUnknown", customer)
+	assert.Error(t, err)
+	_, err = eng.Query(context.Background(), ast.NewDataContext(), kb, "IsEligible", customer, customer)
+	assert.Error(t, err)
+	_, err = eng.Query(context.Background(), ast.NewDataContext(), kb, "IsEligible")
+	assert.Error(t, err)
+}
+
+func TestQueryGrlErrors(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	duplicateParameter := `query Twice(A, A) { A.Ok }`
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("QueryErrors", "0.0.1", pkg.NewBytesResource([]byte(duplicateParameter)))
+	assert.Error(t, err)
+	duplicateQuery := `query Same() { A.Ok } query Same() { A.Ko }`
+	err = builder.NewRuleBuilder(lib).BuildRuleFromResource("QueryErrors", "0.0.2", pkg.NewBytesResource([]byte(duplicateQuery)))
+	assert.Error(t, err)
+}
+
+type QueryFact struct {
+	Text string
+	Done bool
+}
+
+func TestQueryAsFactName(t *testing.T) {
+	grl := `
+rule R "d" {
+	when
+		Query.Text == "a" && !Query.Done
+	then
+		Query.Done = true;
+}
+
+QUERY IsDone() {
+	Query.Done
+}
+`
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("QueryFact", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("QueryFact", "0.0.1")
+	assert.NoError(t, err)
+	assert.Len(t, kb.QueryEntries, 1)
+
+	fact := &QueryFact{Text: "a"}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Query", fact))
+	assert.NoError(t, engine.NewGruleEngine().Execute(dctx, kb))
+	assert.True(t, fact.Done)
+
+	// an entry starting with any other name is neither a rule nor a query.
+	err = builder.NewRuleBuilder(lib).BuildRuleFromResource("QueryFact", "0.0.2", pkg.NewBytesResource([]byte(`question IsDone() { Query.Done }`)))
+	assert.Error(t, err)
+}
+
+func TestQuerySerialization(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("QueryTest", "0.0.1", pkg.NewBytesResource([]byte(queryGRL)))
+	assert.NoError(t, err)
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, lib.StoreKnowledgeBaseToWriter(buffer, "QueryTest", "0.0.1"))
+
+	loaded, err := ast.NewKnowledgeLibrary().LoadKnowledgeBaseFromReader(buffer, true)
+	assert.NoError(t, err)
+	assert.True(t, lib.GetKnowledgeBase("QueryTest", "0.0.1").IsIdentical(loaded))
+
+	result, err := engine.NewGruleEngine().Query(context.Background(), ast.NewDataContext(), loaded, "IsEligible", &QueryCustomer{Age: 30, Income: 5000, Employed: true})
+	assert.NoError(t, err)
+	assert.True(t, result.Answer)
+	assert.Equal(t, []string{"AdultRule", "CreditRule", "EligibleRule"}, result.RuleNames())
+}
@@ -0,0 +1,168 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type ReteFact struct {
+	Stage int
+	Total int
+	Flags map[string]bool
+}
+
+const reteNetworkGRL = `
+rule StageOne "first stage" salience 10 {
+	when
+		Fact.Stage == 1
+	then
+		Fact.Stage = 2;
+		Fact.Total = Fact.Total + 1;
+}
+
+rule StageTwo "second stage" salience 10 {
+	when
+		Fact.Stage == 2
+	then
+		Fact.Stage = 3;
+}
+
+rule FlagA "raise flag a" {
+	when
+		Fact.Flags["a"] == false
+	then
+		Fact.Flags["a"] = true;
+}
+
+rule FlagB "raise flag b after the stages" {
+	when
+		Fact.Flags["b"] == false && Fact.Stage == 3
+	then
+		Fact.Flags["b"] = true;
+}
+
+rule Large "large totals" {
+	when
+		Fact.Total > 100
+	then
+		Fact.Total = 0;
+}
+`
+
+// evaluationCounter counts the evaluations of the when scope of each rule entry.
+type evaluationCounter struct {
+	evaluations map[string]int
+}
+
+func (c *evaluationCounter) EvaluateRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry, candidate bool) {
+	c.evaluations[entry.RuleName]++
+}
+
+func (c *evaluationCounter) ExecuteRuleEntry(ctx context.Context, cycle uint64, entry *ast.RuleEntry) {
+}
+
+func (c *evaluationCounter) BeginCycle(ctx context.Context, cycle uint64) {}
+
+func executeReteNetwork(t *testing.T, kb *ast.KnowledgeBase, useNetwork bool) (*ReteFact, *engine.ExecutionResult, map[string]int) {
+	t.Helper()
+	fact := &ReteFact{Stage: 1, Flags: map[string]bool{"a": false, "b": false}}
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Fact", fact))
+	counter := &evaluationCounter{evaluations: make(map[string]int)}
+	eng := engine.NewGruleEngine()
+	eng.UseReteNetwork = useNetwork
+	eng.Listeners = []engine.GruleEngineListener{counter}
+	result, err := eng.ExecuteWithResult(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+
+	return fact, result, counter.evaluations
+}
+
+func TestReteNetwork(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("ReteTest", "0.0.1", pkg.NewBytesResource([]byte(reteNetworkGRL)))
+	assert.NoError(t, err)
+	compiled, err := lib.CompileKnowledgeBase("ReteTest", "0.0.1")
+	assert.NoError(t, err)
+	network := compiled.ReteNetwork()
+	assert.Len(t, network.RuleEntries(), 5)
+	assert.Same(t, network, compiled.NewSession().ReteNetwork())
+
+	// the rule entries known to fail are not candidates, until a change propagates to them.
+	matcher := network.NewMatcher(compiled.NewSession().WorkingMemory)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, matcher.AppendCandidates(nil))
+	matcher.Evaluated(1, false, nil)
+	matcher.Evaluated(4, false, nil)
+	matcher.Evaluated(2, true, nil)
+	assert.Equal(t, []int{0, 2, 3}, matcher.AppendCandidates(nil))
+	matcher.Evaluated(4, false, fmt.Errorf("failed"))
+	assert.Equal(t, []int{0, 2, 3, 4}, matcher.AppendCandidates(nil))
+	matcher.Detach()
+
+	fact, result, evaluations := executeReteNetwork(t, compiled.NewSession(), false)
+	reteFact, reteResult, reteEvaluations := executeReteNetwork(t, compiled.NewSession(), true)
+
+	// the network changes how many times the when scopes are evaluated, not the outcome.
+	assert.Equal(t, fact, reteFact)
+	assert.Equal(t, 3, reteFact.Stage)
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, reteFact.Flags)
+	assert.Equal(t, len(result.FiredRules), len(reteResult.FiredRules))
+	for i := range result.FiredRules {
+		assert.Equal(t, result.FiredRules[i].RuleName, reteResult.FiredRules[i].RuleName)
+	}
+
+	// Large is evaluated again only once its total changed, FlagA once its flag changed.
+	assert.Equal(t, 2, reteEvaluations["Large"])
+	assert.Equal(t, 2, reteEvaluations["FlagA"])
+	assert.Equal(t, int(result.Cycles)+1, evaluations["Large"])
+	for name, count := range reteEvaluations {
+		assert.LessOrEqual(t, count, evaluations[name])
+	}
+}
+
+func TestReteNetworkRuleEntriesChange(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ReteTest", "0.0.1", pkg.NewBytesResource([]byte(reteNetworkGRL)))
+	assert.NoError(t, err)
+	kb := lib.GetKnowledgeBase("ReteTest", "0.0.1")
+	network := kb.ReteNetwork()
+	assert.Same(t, network, kb.ReteNetwork())
+
+	// the instances are built with their own network.
+	instance, err := lib.NewKnowledgeBaseInstance("ReteTest", "0.0.1")
+	assert.NoError(t, err)
+	assert.NotSame(t, network, instance.ReteNetwork())
+	assert.Same(t, instance.RuleEntries["Large"], instance.ReteNetwork().RuleEntries()[4])
+
+	// the network is built again once the rule entries changed.
+	kb.RemoveRuleEntry("Large")
+	assert.NotSame(t, network, kb.ReteNetwork())
+	assert.Len(t, kb.ReteNetwork().RuleEntries(), 5)
+
+	instance, err = lib.NewKnowledgeBaseInstance("ReteTest", "0.0.1")
+	assert.NoError(t, err)
+	_, result, _ := executeReteNetwork(t, instance, true)
+	assert.NotContains(t, result.FireCounts, "Large")
+}
@@ -0,0 +1,268 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	noLoopGRL = `
+rule Count "count without retracting" no-loop {
+	when
+		Fact.Count < 10
+	then
+		Fact.Count = Fact.Count + 1;
+}
+
+rule Other "change something else" {
+	when
+		Fact.Other < 3
+	then
+		Fact.Other = Fact.Other + 1;
+}
+`
+	noLoopPingPongGRL = `
+rule Ping "ping without retracting" no-loop {
+	when
+		Fact.Count < 10
+	then
+		Fact.Trail = Fact.Trail + "Ping,";
+}
+
+rule Pong "pong without retracting" no-loop {
+	when
+		Fact.Count < 10
+	then
+		Fact.Trail = Fact.Trail + "Pong,";
+}
+`
+	noLoopReactivatedGRL = `
+rule Count "count without retracting" no-loop {
+	when
+		Fact.Count < 3
+	then
+		Fact.Count = Fact.Count + 1;
+		Fact.Trail = Fact.Trail + "Count,";
+}
+
+rule Reset "reset the count once" {
+	when
+		Fact.Count == 1 && Fact.Other == 0
+	then
+		Fact.Other = 1;
+		Fact.Count = 0;
+}
+`
+	lockOnActiveGRL = `
+rule Count "count without retracting" lock-on-active {
+	when
+		Fact.Count < 10
+	then
+		Fact.Count = Fact.Count + 1;
+}
+
+rule Other "change something else" {
+	when
+		Fact.Other < 3
+	then
+		Fact.Other = Fact.Other + 1;
+}
+`
+	activationGroupGRL = `
+rule BigDiscount "the best discount wins" salience 10 lock-on-active activation-group "discount" {
+	when
+		Fact.Count == 0
+	then
+		Fact.Trail = Fact.Trail + "BigDiscount,";
+}
+
+rule SmallDiscount "cancelled by the big discount" activation-group "discount" {
+	when
+		Fact.Count == 0
+	then
+		Fact.Trail = Fact.Trail + "SmallDiscount,";
+}
+
+rule Shipping "not in the group" no-loop false lock-on-active {
+	when
+		Fact.Count == 0
+	then
+		Fact.Trail = Fact.Trail + "Shipping,";
+}
+`
+)
+
+type AttributeFact struct {
+	Count int
+	Other int
+	Trail string
+}
+
+func buildAttributeKnowledgeBase(t *testing.T, grl string) *ast.KnowledgeLibrary {
+	t.Helper()
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("AttributeTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+
+	return lib
+}
+
+func executeAttributeGRL(t *testing.T, grl string) *AttributeFact {
+	t.Helper()
+	lib := buildAttributeKnowledgeBase(t, grl)
+	kb, err := lib.NewKnowledgeBaseInstance("AttributeTest", "0.0.1")
+	assert.NoError(t, err)
+
+	fact := &AttributeFact{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+	err = engine.NewGruleEngine().Execute(dctx, kb)
+	assert.NoError(t, err)
+
+	return fact
+}
+
+func TestNoLoopAttribute(t *testing.T) {
+	fact := executeAttributeGRL(t, noLoopGRL)
+	// Other doesn't change what the when scope of Count reads, so Count is not activated again.
+	assert.Equal(t, 1, fact.Count)
+	assert.Equal(t, 3, fact.Other)
+}
+
+func TestNoLoopAttributePingPong(t *testing.T) {
+	// each rule changes the fact, but not what the when scope of the other one reads.
+	fact := executeAttributeGRL(t, noLoopPingPongGRL)
+	assert.Equal(t, "Ping,Pong,", fact.Trail)
+}
+
+func TestNoLoopAttributeReactivated(t *testing.T) {
+	// Reset changes the count Count reads, so Count is activated again.
+	fact := executeAttributeGRL(t, noLoopReactivatedGRL)
+	assert.Equal(t, "Count,Count,", fact.Trail)
+	assert.Equal(t, 1, fact.Count)
+}
+
+// ResetCount sets the count back to zero, the rules don't see the change as an assignment.
+func (f *AttributeFact) ResetCount() int {
+	f.Count = 0
+
+	return 1
+}
+
+func TestNoLoopAttributeReactivatedByFunctions(t *testing.T) {
+	const countGRL = `
+rule Count "count without retracting" no-loop {
+	when
+		Fact.Count < 3
+	then
+		Fact.Count = Fact.Count + 1;
+		Fact.Trail = Fact.Trail + "Count,";
+}
+`
+	testData := []struct {
+		name  string
+		reset string
+		trail string
+		count int
+	}{
+		{name: "method", reset: "Fact.Other = Fact.ResetCount();", trail: "Count,Count,", count: 1},
+		{name: "custom function", reset: "Fact.Other = 1; Reset(Fact);", trail: "Count,Count,", count: 1},
+		{name: "modify", reset: `Fact.Other = 1; Modify("Fact", Spare);`, trail: "Count,", count: 1},
+		{name: "changed", reset: `Fact.Other = 1; Changed("Fact.Count");`, trail: "Count,Count,", count: 2},
+	}
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			grl := countGRL + `
+rule Reset "reset the count once" {
+	when
+		Fact.Count == 1 && Fact.Other == 0
+	then
+		` + data.reset + `
+}
+`
+			lib := buildAttributeKnowledgeBase(t, grl)
+			assert.NoError(t, lib.Functions.Register("Reset", func(fact *AttributeFact) { fact.Count = 0 }))
+			kb, err := lib.NewKnowledgeBaseInstance("AttributeTest", "0.0.1")
+			assert.NoError(t, err)
+			dctx := ast.NewDataContext()
+			assert.NoError(t, dctx.Add("Fact", &AttributeFact{}))
+			assert.NoError(t, dctx.Add("Spare", &AttributeFact{Other: 1}))
+			assert.NoError(t, engine.NewGruleEngine().Execute(dctx, kb))
+
+			// the rule entry changing the fact through a function reactivates Count, whose trail is on the fact.
+			fact := dctx.Get("Fact").Value().Interface().(*AttributeFact)
+			assert.Equal(t, data.trail, fact.Trail)
+			assert.Equal(t, data.count, fact.Count)
+		})
+	}
+}
+
+func TestLockOnActiveAttribute(t *testing.T) {
+	fact := executeAttributeGRL(t, lockOnActiveGRL)
+	assert.Equal(t, 1, fact.Count)
+	assert.Equal(t, 3, fact.Other)
+}
+
+func TestActivationGroupAttribute(t *testing.T) {
+	fact := executeAttributeGRL(t, activationGroupGRL)
+	assert.Equal(t, "BigDiscount,Shipping,", fact.Trail)
+}
+
+func TestRuleAttributesCatalog(t *testing.T) {
+	lib := buildAttributeKnowledgeBase(t, activationGroupGRL)
+	kb := lib.GetKnowledgeBase("AttributeTest", "0.0.1")
+	assert.True(t, kb.RuleEntries["BigDiscount"].LockOnActive)
+	assert.Equal(t, "discount", kb.RuleEntries["SmallDiscount"].ActivationGroup)
+	assert.False(t, kb.RuleEntries["Shipping"].NoLoop)
+
+	buff := &bytes.Buffer{}
+	err := lib.StoreKnowledgeBaseToWriter(buff, "AttributeTest", "0.0.1")
+	assert.NoError(t, err)
+	loaded, err := ast.NewKnowledgeLibrary().LoadKnowledgeBaseFromReader(buff, true)
+	assert.NoError(t, err)
+	assert.True(t, loaded.RuleEntries["BigDiscount"].LockOnActive)
+	assert.True(t, loaded.RuleEntries["Shipping"].LockOnActive)
+	assert.Equal(t, "discount", loaded.RuleEntries["BigDiscount"].ActivationGroup)
+
+	lib = buildAttributeKnowledgeBase(t, noLoopGRL)
+	buff = &bytes.Buffer{}
+	err = lib.StoreKnowledgeBaseToWriter(buff, "AttributeTest", "0.0.1")
+	assert.NoError(t, err)
+	loaded, err = ast.NewKnowledgeLibrary().LoadKnowledgeBaseFromReader(buff, true)
+	assert.NoError(t, err)
+	assert.True(t, loaded.RuleEntries["Count"].NoLoop)
+}
+
+func TestRuleAttributesInvalidValue(t *testing.T) {
+	for _, grl := range []string{
+		`rule Bad "flag must be a boolean" no-loop "yes" { when true then Retract("Bad"); }`,
+		`rule Bad "group must be a string" activation-group { when true then Retract("Bad"); }`,
+	} {
+		lib := ast.NewKnowledgeLibrary()
+		rb := builder.NewRuleBuilder(lib)
+		err := rb.BuildRuleFromResource("AttributeTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,111 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+const scheduleGRL = `
+rule SummerPrice "only during the summer" date-effective "2024-06-01" date-expires "2024-09-01T00:00:00Z" {
+	when
+		Fact.Count == 0
+	then
+		Fact.Trail = Fact.Trail + "Summer,";
+		Retract("SummerPrice");
+}
+
+rule AlwaysPrice "always active" {
+	when
+		Fact.Count == 0
+	then
+		Fact.Trail = Fact.Trail + "Always,";
+		Retract("AlwaysPrice");
+}
+
+rule DisabledPrice "switched off" enabled false {
+	when
+		Fact.Count == 0
+	then
+		Fact.Trail = Fact.Trail + "Disabled,";
+		Retract("DisabledPrice");
+}
+`
+
+func executeSchedule(t *testing.T, lib *ast.KnowledgeLibrary, now time.Time) string {
+	t.Helper()
+	kb, err := lib.NewKnowledgeBaseInstance("ScheduleTest", "0.0.1")
+	assert.NoError(t, err)
+	fact := &AttributeFact{}
+	dctx := ast.NewDataContext()
+	err = dctx.Add("Fact", fact)
+	assert.NoError(t, err)
+	eng := engine.NewGruleEngine()
+	eng.Clock = pkg.NewFixedClock(now)
+	err = eng.Execute(dctx, kb)
+	assert.NoError(t, err)
+
+	return fact.Trail
+}
+
+func TestRuleSchedule(t *testing.T) {
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	err := rb.BuildRuleFromResource("ScheduleTest", "0.0.1", pkg.NewBytesResource([]byte(scheduleGRL)))
+	assert.NoError(t, err)
+
+	kb := lib.GetKnowledgeBase("ScheduleTest", "0.0.1")
+	summer := kb.RuleEntries["SummerPrice"]
+	assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local), summer.DateEffective)
+	assert.True(t, summer.DateExpires.Equal(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, kb.RuleEntries["DisabledPrice"].IsEnabled())
+	assert.True(t, kb.RuleEntries["AlwaysPrice"].IsEnabled())
+
+	assert.Equal(t, "Always,", executeSchedule(t, lib, time.Date(2024, 5, 31, 12, 0, 0, 0, time.Local)))
+	assert.Equal(t, "Summer,Always,", executeSchedule(t, lib, time.Date(2024, 7, 15, 12, 0, 0, 0, time.Local)))
+	assert.Equal(t, "Always,", executeSchedule(t, lib, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)))
+
+	// the catalog keeps the schedule.
+	buff := &bytes.Buffer{}
+	err = lib.StoreKnowledgeBaseToWriter(buff, "ScheduleTest", "0.0.1")
+	assert.NoError(t, err)
+	loaded, err := ast.NewKnowledgeLibrary().LoadKnowledgeBaseFromReader(buff, true)
+	assert.NoError(t, err)
+	assert.True(t, loaded.RuleEntries["SummerPrice"].DateEffective.Equal(summer.DateEffective))
+	assert.True(t, loaded.RuleEntries["SummerPrice"].DateExpires.Equal(summer.DateExpires))
+	assert.True(t, loaded.RuleEntries["AlwaysPrice"].DateExpires.IsZero())
+	assert.True(t, loaded.RuleEntries["DisabledPrice"].Disabled)
+}
+
+func TestRuleScheduleInvalidDate(t *testing.T) {
+	for _, grl := range []string{
+		`rule Bad "not a date" date-effective "next monday" { when true then Retract("Bad"); }`,
+		`rule Bad "not a string" date-expires true { when true then Retract("Bad"); }`,
+		`rule Bad "not a boolean" enabled "no" { when true then Retract("Bad"); }`,
+	} {
+		lib := ast.NewKnowledgeLibrary()
+		rb := builder.NewRuleBuilder(lib)
+		err := rb.BuildRuleFromResource("ScheduleTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,100 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/stretchr/testify/assert"
+)
+
+const sessionGRL = `
+rule Double "double the count" salience 10 {
+	when
+		Fact.Count > 0 && Fact.Count < 100
+	then
+		Fact.Count = Fact.Count * 2;
+		Fact.Trail = Fact.Trail + "d";
+}
+
+rule Done "the count is large enough" {
+	when
+		Fact.Count >= 100
+	then
+		Fact.Other = Fact.Count;
+		Retract("Done");
+}
+`
+
+func TestKnowledgeBaseSessions(t *testing.T) {
+	lib := buildAttributeKnowledgeBase(t, sessionGRL)
+	compiled, err := lib.CompileKnowledgeBase("AttributeTest", "0.0.1")
+	assert.NoError(t, err)
+	assert.True(t, compiled.IsCompiled())
+	assert.False(t, lib.GetKnowledgeBase("AttributeTest", "0.0.1").IsCompiled())
+	_, err = lib.CompileKnowledgeBase("Missing", "0.0.1")
+	assert.Error(t, err)
+
+	// the rule entries of the compiled knowledge base and of its sessions can't be changed.
+	err = compiled.AddRuleEntry(&ast.RuleEntry{RuleName: "Other"})
+	assert.Error(t, err)
+	compiled.RemoveRuleEntry("Done")
+	assert.True(t, compiled.ContainsRuleEntry("Done"))
+	assert.True(t, compiled.NewSession().IsCompiled())
+
+	// the sessions share the AST, but each one has its own execution state.
+	facts := make([]*AttributeFact, 50)
+	var group sync.WaitGroup
+	for i := range facts {
+		facts[i] = &AttributeFact{Count: i + 1}
+		group.Add(1)
+		go func(fact *AttributeFact) {
+			defer group.Done()
+			session := compiled.NewSession()
+			assert.Same(t, compiled.RuleEntries["Done"], session.RuleEntries["Done"])
+			dctx := ast.NewDataContext()
+			err := dctx.Add("Fact", fact)
+			assert.NoError(t, err)
+			err = engine.NewGruleEngine().Execute(dctx, session)
+			assert.NoError(t, err)
+			assert.True(t, session.IsRuleRetracted("Done"))
+			count, _, cached := session.WorkingMemory.EvaluatedAtomValue(session.RuleEntries["Done"].WhenScope.Expression.LeftExpression.ExpressionAtom)
+			assert.True(t, cached)
+			assert.Equal(t, int64(fact.Count), count.Int())
+		}(facts[i])
+	}
+	group.Wait()
+
+	for i, fact := range facts {
+		expected := i + 1
+		trail := ""
+		for expected < 100 {
+			expected *= 2
+			trail += "d"
+		}
+		assert.Equal(t, expected, fact.Count, fmt.Sprintf("fact %d", i))
+		assert.Equal(t, expected, fact.Other)
+		assert.Equal(t, trail, fact.Trail)
+	}
+	assert.False(t, compiled.IsRuleRetracted("Done"))
+	_, cached := compiled.WorkingMemory.EvaluatedValue(compiled.RuleEntries["Done"].WhenScope.Expression)
+	assert.False(t, cached)
+	_, _, cached = compiled.WorkingMemory.EvaluatedAtomValue(compiled.RuleEntries["Done"].WhenScope.Expression.LeftExpression.ExpressionAtom)
+	assert.False(t, cached)
+}
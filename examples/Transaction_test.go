@@ -0,0 +1,162 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package examples
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+type TransactionFact struct {
+	Status string
+	Limit  *int64
+	Tags   map[string]string
+	Items  []string
+
+	cancel context.CancelFunc
+}
+
+func (f *TransactionFact) Cancel() {
+	f.cancel()
+}
+
+const transactionGRL = `
+rule Change "change everything" salience 10 {
+	when
+		Fact.Status == "new"
+	then
+		Fact.Status = "changed";
+		Fact.Limit = 5;
+		Fact.Tags["color"] = "blue";
+		Fact.Tags["size"] = "large";
+		Fact.Items.Append("pen");
+		Fact.Items[0] = "paper";
+		Json.status = "changed";
+		Json.added = 1;
+		Added = 3;
+		Changed = true;
+}
+
+rule Fail "fails halfway" {
+	when
+		Fact.Status == "changed"
+	then
+		Fact.Status = "failing";
+		Fact.Missing = 1;
+}
+`
+
+const transactionCancelGRL = `
+rule Change "change and cancel" {
+	when
+		Fact.Status == "new"
+	then
+		Fact.Status = "changed";
+		Fact.Items.Append("pen");
+		Fact.Cancel();
+}
+`
+
+func newTransactionFact(cancel context.CancelFunc) *TransactionFact {
+	limit := int64(10)
+
+	return &TransactionFact{
+		Status: "new",
+		Limit:  &limit,
+		Tags:   map[string]string{"color": "red"},
+		Items:  []string{"book"},
+		cancel: cancel,
+	}
+}
+
+func newTransactionContext(t *testing.T, fact *TransactionFact) ast.IDataContext {
+	dctx := ast.NewDataContext()
+	assert.NoError(t, dctx.Add("Fact", fact))
+	assert.NoError(t, dctx.AddJSON("Json", []byte(`{"status":"new"}`)))
+	assert.NoError(t, dctx.Add("Changed", false))
+
+	return dctx
+}
+
+func buildTransactionKnowledgeBase(t *testing.T, grl string) *ast.KnowledgeBase {
+	lib := ast.NewKnowledgeLibrary()
+	err := builder.NewRuleBuilder(lib).BuildRuleFromResource("TransactionTest", "0.0.1", pkg.NewBytesResource([]byte(grl)))
+	assert.NoError(t, err)
+	kb, err := lib.NewKnowledgeBaseInstance("TransactionTest", "0.0.1")
+	assert.NoError(t, err)
+
+	return kb
+}
+
+func TestRollbackOnFailure(t *testing.T) {
+	kb := buildTransactionKnowledgeBase(t, transactionGRL)
+	eng := engine.NewGruleEngine()
+
+	// without rolling back, the facts are left as the failing rule left them.
+	fact := newTransactionFact(nil)
+	dctx := newTransactionContext(t, fact)
+	result, err := eng.ExecuteWithResult(context.Background(), dctx, kb)
+	assert.Error(t, err)
+	assert.False(t, result.RolledBack)
+	assert.Equal(t, "failing", fact.Status)
+	assert.NotNil(t, dctx.Get("Added"))
+
+	eng.RollbackOnFailure = true
+	fact = newTransactionFact(nil)
+	dctx = newTransactionContext(t, fact)
+	result, err = eng.ExecuteWithResult(context.Background(), dctx, kb)
+	assert.Error(t, err)
+	assert.True(t, result.RolledBack)
+	assert.Equal(t, newTransactionFact(nil), fact)
+	status, err := dctx.Get("Json").GetObjectValueByField("status")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", status.String())
+	_, err = dctx.Get("Json").GetObjectValueByField("added")
+	assert.Error(t, err)
+	assert.Nil(t, dctx.Get("Added"))
+	assert.False(t, dctx.Get("Changed").Value().Bool())
+}
+
+func TestRollbackOnCancel(t *testing.T) {
+	kb := buildTransactionKnowledgeBase(t, transactionCancelGRL)
+	eng := engine.NewGruleEngine()
+	eng.RollbackOnFailure = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fact := newTransactionFact(cancel)
+	dctx := newTransactionContext(t, fact)
+	result, err := eng.ExecuteWithResult(ctx, dctx, kb)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, engine.StopCanceled, result.StopReason)
+	assert.True(t, result.RolledBack)
+	assert.Equal(t, "new", fact.Status)
+	assert.Equal(t, []string{"book"}, fact.Items)
+
+	// a successful execution keeps its changes.
+	fact = newTransactionFact(func() {})
+	dctx = newTransactionContext(t, fact)
+	result, err = eng.ExecuteWithResult(context.Background(), dctx, kb)
+	assert.NoError(t, err)
+	assert.False(t, result.RolledBack)
+	assert.Equal(t, "changed", fact.Status)
+	assert.Equal(t, []string{"book", "pen"}, fact.Items)
+}
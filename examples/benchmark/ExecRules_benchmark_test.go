@@ -15,6 +15,7 @@
 package benchmark
 
 import (
+	"context"
 	"fmt"
 	"github.com/hyperjumptech/grule-rule-engine/ast"
 	"github.com/hyperjumptech/grule-rule-engine/builder"
@@ -65,6 +66,39 @@ func Benchmark_Grule_Execution_Engine(b *testing.B) {
 	}
 }
 
+// Benchmark_Grule_Execute_With_Context loads the rules once, so only the executions are measured.
+func Benchmark_Grule_Execute_With_Context(b *testing.B) {
+	rules := []struct {
+		name string
+		fun  func()
+	}{
+		{"100 rules", load100RulesIntoKnowledgebase},
+		{"1000 rules", load1000RulesIntoKnowledgebase},
+	}
+	for _, rule := range rules {
+		b.Run(rule.name, func(b *testing.B) {
+			rule.fun()
+			e := engine.NewGruleEngine()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				f1 := RideFact{
+					Distance: 6000,
+					Duration: 121,
+				}
+				dataCtx := ast.NewDataContext()
+				err := dataCtx.Add("Fact", &f1)
+				if err != nil {
+					b.Fatal(err)
+				}
+				err = e.ExecuteWithContext(context.Background(), dataCtx, knowledgeBase)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 func load100RulesIntoKnowledgebase() {
 	input, _ := os.ReadFile("100_rules.grl")
 	rules := string(input)
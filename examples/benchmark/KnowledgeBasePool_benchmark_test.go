@@ -0,0 +1,70 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package benchmark
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+/**
+  Benchmarking how long it takes to obtain a knowledge base instance of 1000 rules,
+  from KnowledgeLibrary.NewKnowledgeBaseInstance, from a compiled knowledge base session and from a KnowledgeBasePool.
+  Please refer docs/benchmarking_en.md for more info
+*/
+
+func Benchmark_Grule_Knowledge_Base_Instance(b *testing.B) {
+	input, _ := os.ReadFile("1000_rules.grl")
+	lib := ast.NewKnowledgeLibrary()
+	rb := builder.NewRuleBuilder(lib)
+	_ = rb.BuildRuleFromResource("exec_rules_test", "0.1.1", pkg.NewBytesResource(input))
+
+	b.Run("new instance", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := lib.NewKnowledgeBaseInstance("exec_rules_test", "0.1.1")
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("session", func(b *testing.B) {
+		compiled, err := lib.CompileKnowledgeBase("exec_rules_test", "0.1.1")
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = compiled.NewSession()
+		}
+	})
+	b.Run("pool", func(b *testing.B) {
+		pool, err := lib.NewKnowledgeBasePool("exec_rules_test", "0.1.1", 1)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			knowledgeBase, err := pool.Get()
+			if err != nil {
+				b.Fatal(err)
+			}
+			pool.Put(knowledgeBase)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+/**
+  Benchmarking the evaluation of the when scopes one after another against GruleEngine.EvaluationWorkers,
+  with 100 and 1000 rules calling a costly method of the fact. About one rule in ten holds, the first one is executed.
+  Please refer docs/benchmarking_en.md for more info
+*/
+
+// ScoringFact has a costly method, safe for concurrent use.
+type ScoringFact struct {
+	Customer string
+	Approved bool
+}
+
+// Risk hashes the customer many times to simulate a costly check.
+func (f *ScoringFact) Risk(seed int64) int64 {
+	hash := fnv.New64a()
+	for i := 0; i < 200; i++ {
+		_, _ = fmt.Fprintf(hash, "%s-%d-%d", f.Customer, seed, i)
+	}
+
+	return int64(hash.Sum64() % 1000)
+}
+
+func loadScoringRules(ruleCount int) *ast.KnowledgeLibrary {
+	grl := &strings.Builder{}
+	for i := 0; i < ruleCount; i++ {
+		fmt.Fprintf(grl, "rule Scoring%d \"scoring %d\" { when Fact.Approved == false && Fact.Risk(%d) > 900 then Fact.Approved = true; }\n", i, i, i)
+	}
+	lib := ast.NewKnowledgeLibrary()
+	_ = builder.NewRuleBuilder(lib).BuildRuleFromResource("scoring_rules", "0.1.1", pkg.NewBytesResource([]byte(grl.String())))
+
+	return lib
+}
+
+func Benchmark_Grule_Parallel_Evaluation(b *testing.B) {
+	for _, ruleCount := range []int{100, 1000} {
+		lib := loadScoringRules(ruleCount)
+		for _, workers := range []int{0, 2, 4, 8} {
+			kb, _ := lib.NewKnowledgeBaseInstance("scoring_rules", "0.1.1")
+			b.Run(fmt.Sprintf("%d rules/%d workers", ruleCount, workers), func(b *testing.B) {
+				e := engine.NewGruleEngine()
+				e.EvaluationWorkers = workers
+				for i := 0; i < b.N; i++ {
+					fact := &ScoringFact{Customer: "customer"}
+					dataCtx := ast.NewDataContext()
+					err := dataCtx.Add("Fact", fact)
+					if err != nil {
+						b.Fail()
+					}
+					err = e.ExecuteWithContext(context.Background(), dataCtx, kb)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if !fact.Approved {
+						b.Fatal("no scoring rule was executed")
+					}
+				}
+			})
+		}
+	}
+}
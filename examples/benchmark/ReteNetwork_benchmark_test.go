@@ -0,0 +1,86 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hyperjumptech/grule-rule-engine/ast"
+	"github.com/hyperjumptech/grule-rule-engine/builder"
+	"github.com/hyperjumptech/grule-rule-engine/engine"
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+/**
+  Benchmarking the execution of 100 and 1000 rules, matched by re-walking every when scope in each cycle
+  against matched through the RETE network of the knowledge base. Each rule marks itself done and raises the flag of
+  the next rule, so each cycle changes the facts only two rules depend on.
+  Please refer docs/benchmarking_en.md for more info
+*/
+
+// FlagFact has a flag raised by the previous rule and a done flag per rule.
+type FlagFact struct {
+	Flags map[string]bool
+}
+
+func loadFlagRules(ruleCount int) *ast.KnowledgeLibrary {
+	grl := &strings.Builder{}
+	for i := 0; i < ruleCount; i++ {
+		fmt.Fprintf(grl, "rule Flag%d \"flag %d\" { when Fact.Flags[\"f%d\"] && Fact.Flags[\"d%d\"] == false then Fact.Flags[\"d%d\"] = true; Fact.Flags[\"f%d\"] = true; }\n", i, i, i, i, i, i+1)
+	}
+	lib := ast.NewKnowledgeLibrary()
+	_ = builder.NewRuleBuilder(lib).BuildRuleFromResource("flag_rules", "0.1.1", pkg.NewBytesResource([]byte(grl.String())))
+
+	return lib
+}
+
+func Benchmark_Grule_Rete_Network(b *testing.B) {
+	for _, ruleCount := range []int{100, 1000} {
+		lib := loadFlagRules(ruleCount)
+		compiled, err := lib.CompileKnowledgeBase("flag_rules", "0.1.1")
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, useNetwork := range []bool{false, true} {
+			name := "when scopes"
+			if useNetwork {
+				name = "rete network"
+			}
+			b.Run(fmt.Sprintf("%d rules/%s", ruleCount, name), func(b *testing.B) {
+				e := engine.NewGruleEngine()
+				e.UseReteNetwork = useNetwork
+				for i := 0; i < b.N; i++ {
+					fact := &FlagFact{Flags: make(map[string]bool, 2*ruleCount+1)}
+					for j := 0; j <= ruleCount; j++ {
+						fact.Flags[fmt.Sprintf("f%d", j)] = j == 0
+						fact.Flags[fmt.Sprintf("d%d", j)] = false
+					}
+					dataCtx := ast.NewDataContext()
+					err := dataCtx.Add("Fact", fact)
+					if err != nil {
+						b.Fail()
+					}
+					err = e.ExecuteWithContext(context.Background(), dataCtx, compiled.NewSession())
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,269 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package model
+
+import (
+	"reflect"
+
+	"github.com/hyperjumptech/grule-rule-engine/pkg"
+)
+
+// NewJournal creates an empty Journal.
+func NewJournal() *Journal {
+
+	return &Journal{
+		undos: make([]func() error, 0),
+	}
+}
+
+// Journal records how to revert the changes made through the journaled value nodes, so they can be rolled back.
+type Journal struct {
+	undos []func() error
+}
+
+// Record records the function reverting a change.
+func (j *Journal) Record(undo func() error) {
+	j.undos = append(j.undos, undo)
+}
+
+// Len returns the number of changes recorded.
+func (j *Journal) Len() int {
+
+	return len(j.undos)
+}
+
+// Commit forgets the recorded changes, they can't be rolled back anymore.
+func (j *Journal) Commit() {
+	j.undos = make([]func() error, 0)
+}
+
+// Rollback reverts the recorded changes, the last change first. All the changes are reverted even if some of them
+// fail to, the first failure is returned.
+func (j *Journal) Rollback() error {
+	var firstErr error
+	for i := len(j.undos) - 1; i >= 0; i-- {
+		if err := j.undos[i](); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	j.Commit()
+
+	return firstErr
+}
+
+// NewJournaledValueNode creates a ValueNode that records into the journal how to revert the changes made through it,
+// and through the value nodes of its children.
+func NewJournaledValueNode(node ValueNode, journal *Journal) ValueNode {
+	if journaled, ok := node.(*JournaledValueNode); ok && journaled.journal == journal {
+
+		return journaled
+	}
+
+	return &JournaledValueNode{
+		ValueNode: node,
+		journal:   journal,
+	}
+}
+
+// JournaledValueNode is a ValueNode that records into its Journal how to revert the changes made by
+// SetObjectValueByField, SetArrayValueAt, SetMapValueAt, AppendValue and the Append function.
+// The changes a fact's own function makes are not recorded.
+type JournaledValueNode struct {
+	ValueNode
+
+	journal *Journal
+}
+
+// wrap makes the child value node journaled too.
+func (node *JournaledValueNode) wrap(child ValueNode, err error) (ValueNode, error) {
+	if err != nil {
+
+		return nil, err
+	}
+
+	return NewJournaledValueNode(child, node.journal), nil
+}
+
+// Parent returns the journaled value node of the parent value, if this node has one.
+func (node *JournaledValueNode) Parent() ValueNode {
+	if !node.ValueNode.HasParent() {
+
+		return nil
+	}
+
+	return NewJournaledValueNode(node.ValueNode.Parent(), node.journal)
+}
+
+// ContinueWithValue returns a journaled value node to wrap the specified value and treated as child of current node.
+func (node *JournaledValueNode) ContinueWithValue(value reflect.Value, identifiedAs string) ValueNode {
+
+	return NewJournaledValueNode(node.ValueNode.ContinueWithValue(value, identifiedAs), node.journal)
+}
+
+// GetChildNodeByIndex returns the journaled value node of the array element.
+func (node *JournaledValueNode) GetChildNodeByIndex(index int) (ValueNode, error) {
+
+	return node.wrap(node.ValueNode.GetChildNodeByIndex(index))
+}
+
+// GetChildNodeBySelector returns the journaled value node of the map element.
+func (node *JournaledValueNode) GetChildNodeBySelector(index reflect.Value) (ValueNode, error) {
+
+	return node.wrap(node.ValueNode.GetChildNodeBySelector(index))
+}
+
+// GetChildNodeByField returns the journaled value node of the field.
+func (node *JournaledValueNode) GetChildNodeByField(field string) (ValueNode, error) {
+
+	return node.wrap(node.ValueNode.GetChildNodeByField(field))
+}
+
+// SetArrayValueAt sets the array element, the previous element is journaled.
+func (node *JournaledValueNode) SetArrayValueAt(index int, value reflect.Value) error {
+	array := node.ValueNode.Value()
+	if !node.ValueNode.IsArray() || index < 0 || index >= array.Len() {
+
+		return node.ValueNode.SetArrayValueAt(index, value)
+	}
+	oldValue, ok := copyValue(array.Index(index))
+	err := node.ValueNode.SetArrayValueAt(index, value)
+	if err == nil && ok {
+		node.journal.Record(func() error {
+
+			return node.ValueNode.SetArrayValueAt(index, oldValue)
+		})
+	}
+
+	return err
+}
+
+// AppendValue appends the values to the array, the previous array is journaled.
+func (node *JournaledValueNode) AppendValue(value []reflect.Value) error {
+	undo := node.undoAppend()
+	err := node.ValueNode.AppendValue(value)
+	if err == nil && undo != nil {
+		node.journal.Record(undo)
+	}
+
+	return err
+}
+
+// undoAppend returns the function restoring the array as it is before an append, nil if the array isn't changed
+// in place by an append.
+func (node *JournaledValueNode) undoAppend() func() error {
+	array := node.ValueNode.Value()
+	if !node.ValueNode.IsArray() || !array.CanSet() {
+
+		return nil
+	}
+	oldValue, ok := copyValue(array)
+	if !ok {
+
+		return nil
+	}
+
+	return func() error {
+		array.Set(oldValue)
+
+		return nil
+	}
+}
+
+// SetMapValueAt sets the map element, the previous element is journaled, or its absence.
+func (node *JournaledValueNode) SetMapValueAt(index, newValue reflect.Value) error {
+	undo := node.undoMapValueAt(index)
+	err := node.ValueNode.SetMapValueAt(index, newValue)
+	if err == nil && undo != nil {
+		node.journal.Record(undo)
+	}
+
+	return err
+}
+
+// undoMapValueAt returns the function restoring the map element as it is before it's set, it deletes the element
+// if it doesn't exist yet. It's nil if the element can't be set.
+func (node *JournaledValueNode) undoMapValueAt(index reflect.Value) func() error {
+	mapValue := node.ValueNode.Value()
+	if mapValue.Kind() != reflect.Map || mapValue.IsNil() || !index.IsValid() || !index.Type().AssignableTo(mapValue.Type().Key()) {
+
+		return nil
+	}
+	// the map index is invalid if the element doesn't exist, setting it deletes the element.
+	oldValue := mapValue.MapIndex(index)
+
+	return func() error {
+
+		return node.ValueNode.SetMapValueAt(index, oldValue)
+	}
+}
+
+// SetObjectValueByField sets the field, the previous value is journaled.
+func (node *JournaledValueNode) SetObjectValueByField(field string, newValue reflect.Value) error {
+	if node.ValueNode.IsMap() {
+		// the fields of a JSON object are the elements of a map.
+		undo := node.undoMapValueAt(reflect.ValueOf(field))
+		err := node.ValueNode.SetObjectValueByField(field, newValue)
+		if err == nil && undo != nil {
+			node.journal.Record(undo)
+		}
+
+		return err
+	}
+	oldValue, err := node.ValueNode.GetObjectValueByField(field)
+	if err != nil {
+
+		return node.ValueNode.SetObjectValueByField(field, newValue)
+	}
+	if pkg.IsPointerToNumber(oldValue) && pkg.IsNumber(newValue) {
+		// the number the field points to is set, not the field.
+		oldValue = pkg.GetValueElem(oldValue)
+	}
+	oldValue, ok := copyValue(oldValue)
+	err = node.ValueNode.SetObjectValueByField(field, newValue)
+	if err == nil && ok {
+		node.journal.Record(func() error {
+
+			return node.ValueNode.SetObjectValueByField(field, oldValue)
+		})
+	}
+
+	return err
+}
+
+// CallFunction calls the function of the underlying value, the array is journaled before the Append function.
+func (node *JournaledValueNode) CallFunction(funcName string, args ...reflect.Value) (reflect.Value, error) {
+	var undo func() error
+	if funcName == "Append" {
+		undo = node.undoAppend()
+	}
+	retVal, err := node.ValueNode.CallFunction(funcName, args...)
+	if err == nil && undo != nil {
+		node.journal.Record(undo)
+	}
+
+	return retVal, err
+}
+
+// copyValue copies the value so it doesn't change along with the variable holding it, it's false if it can't be copied.
+func copyValue(value reflect.Value) (reflect.Value, bool) {
+	if !value.IsValid() || !value.CanInterface() {
+
+		return reflect.Value{}, false
+	}
+	copied := reflect.New(value.Type()).Elem()
+	copied.Set(value)
+
+	return copied, true
+}
@@ -0,0 +1,98 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type journaledPerson struct {
+	Name    string
+	Age     *int
+	Scores  []int
+	Friends map[string]*journaledPerson
+}
+
+func TestJournaledValueNode(t *testing.T) {
+	age := 30
+	friend := &journaledPerson{Name: "Alice"}
+	person := &journaledPerson{
+		Name:    "Bob",
+		Age:     &age,
+		Scores:  []int{1, 2},
+		Friends: map[string]*journaledPerson{"alice": friend},
+	}
+	journal := NewJournal()
+	node := NewJournaledValueNode(NewGoValueNode(reflect.ValueOf(person), "Person"), journal)
+
+	assert.NoError(t, node.SetObjectValueByField("Name", reflect.ValueOf("Robert")))
+	assert.NoError(t, node.SetObjectValueByField("Age", reflect.ValueOf(31)))
+	scores, err := node.GetChildNodeByField("Scores")
+	assert.NoError(t, err)
+	assert.NoError(t, scores.SetArrayValueAt(0, reflect.ValueOf(10)))
+	assert.NoError(t, scores.AppendValue([]reflect.Value{reflect.ValueOf(3)}))
+	_, err = scores.CallFunction("Append", reflect.ValueOf(4))
+	assert.NoError(t, err)
+	friends, err := node.GetChildNodeByField("Friends")
+	assert.NoError(t, err)
+	assert.NoError(t, friends.SetMapValueAt(reflect.ValueOf("carol"), reflect.ValueOf(&journaledPerson{Name: "Carol"})))
+	alice, err := friends.GetChildNodeBySelector(reflect.ValueOf("alice"))
+	assert.NoError(t, err)
+	assert.NoError(t, alice.SetObjectValueByField("Name", reflect.ValueOf("Alicia")))
+	assert.Equal(t, 7, journal.Len())
+	assert.Equal(t, 31, age)
+	assert.Equal(t, []int{10, 2, 3, 4}, person.Scores)
+
+	assert.NoError(t, journal.Rollback())
+	assert.Equal(t, 0, journal.Len())
+	assert.Equal(t, "Bob", person.Name)
+	assert.Equal(t, 30, age)
+	assert.Equal(t, []int{1, 2}, person.Scores)
+	assert.Len(t, person.Friends, 1)
+	assert.Equal(t, "Alice", friend.Name)
+
+	// the committed changes are kept.
+	assert.NoError(t, node.SetObjectValueByField("Name", reflect.ValueOf("Robert")))
+	journal.Commit()
+	assert.NoError(t, journal.Rollback())
+	assert.Equal(t, "Robert", person.Name)
+}
+
+func TestJournaledJSONValueNode(t *testing.T) {
+	jsonNode, err := NewJSONValueNode(`{"name":"Bob","tags":["a","b"],"address":{"city":null}}`, "Person")
+	assert.NoError(t, err)
+	journal := NewJournal()
+	node := NewJournaledValueNode(jsonNode, journal)
+
+	assert.NoError(t, node.SetObjectValueByField("name", reflect.ValueOf("Robert")))
+	assert.NoError(t, node.SetObjectValueByField("age", reflect.ValueOf(31.0)))
+	tags, err := node.GetChildNodeByField("tags")
+	assert.NoError(t, err)
+	assert.NoError(t, tags.SetArrayValueAt(1, reflect.ValueOf("c")))
+	address, err := node.GetChildNodeByField("address")
+	assert.NoError(t, err)
+	assert.NoError(t, address.SetObjectValueByField("city", reflect.ValueOf("Metro City")))
+	assert.Equal(t, 4, journal.Len())
+
+	assert.NoError(t, journal.Rollback())
+	assert.Equal(t, map[string]interface{}{
+		"name":    "Bob",
+		"tags":    []interface{}{"a", "b"},
+		"address": map[string]interface{}{"city": nil},
+	}, jsonNode.Value().Interface())
+}
@@ -0,0 +1,73 @@
+//  Copyright hyperjumptech/grule-rule-engine Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package pkg
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time to the rule engine. It can be replaced to test or replay time dependent rules.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock that tells the current system time.
+type SystemClock struct{}
+
+// Now returns the current system time.
+func (c *SystemClock) Now() time.Time {
+
+	return time.Now()
+}
+
+// NewFixedClock creates a FixedClock frozen at the specified time.
+func NewFixedClock(now time.Time) *FixedClock {
+
+	return &FixedClock{
+		now: now,
+	}
+}
+
+// FixedClock is a Clock frozen at a specific time, it only moves when told to.
+// It is useful to test time dependent rules, or to replay a past execution.
+type FixedClock struct {
+	lock sync.Mutex
+	now  time.Time
+}
+
+// Now returns the time this clock is frozen at.
+func (c *FixedClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.now
+}
+
+// Set will freeze this clock at the specified time.
+func (c *FixedClock) Set(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = now
+}
+
+// Advance will move this clock forward by the specified duration.
+func (c *FixedClock) Advance(duration time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = c.now.Add(duration)
+}
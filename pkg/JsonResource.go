@@ -19,17 +19,40 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
 
+const (
+	simpleNameStart = `A-Za-z\x{C0}-\x{D6}\x{D8}-\x{F6}\x{F8}-\x{2FF}\x{370}-\x{37D}\x{37F}-\x{1FFF}\x{200C}-\x{200D}\x{2070}-\x{218F}\x{2C00}-\x{2FEF}\x{3001}-\x{D7FF}\x{F900}-\x{FDCF}\x{FDF0}-\x{FFFD}`
+	simpleNamePart  = simpleNameStart + `0-9_\x{B7}\x{300}-\x{36F}\x{203F}-\x{2040}`
+)
+
+var (
+	// annotationKeyRegex matches the SIMPLENAME tokens of the GRL grammar, the annotation keys must be one.
+	annotationKeyRegex = regexp.MustCompile(`^[` + simpleNameStart + `][` + simpleNamePart + `]*$`)
+	// reservedWords are the GRL keywords, they are never lexed as a SIMPLENAME whatever their case.
+	reservedWords = map[string]bool{
+		"rule":     true,
+		"when":     true,
+		"then":     true,
+		"true":     true,
+		"false":    true,
+		"nil":      true,
+		"salience": true,
+	}
+)
+
 // GruleJSON represents a rule in JSON format
 type GruleJSON struct {
-	Name        string        `json:"name"`
-	Description string        `json:"desc"`
-	Salience    int           `json:"salience"`
-	When        interface{}   `json:"when"`
-	Then        []interface{} `json:"then"`
+	Name        string            `json:"name"`
+	Description string            `json:"desc"`
+	Salience    int               `json:"salience"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	When        interface{}       `json:"when"`
+	Then        []interface{}     `json:"then"`
 }
 
 // JSONResource will parse rules in JSON format from underlying resource provider.
@@ -186,6 +209,22 @@ func parseRule(rule *GruleJSON) (string, error) {
 		return "", fmt.Errorf("rule then condition cannot be nil")
 	}
 	var stringBuilder strings.Builder
+	keys := make([]string, 0, len(rule.Annotations))
+	for key := range rule.Annotations {
+		if !annotationKeyRegex.MatchString(key) || reservedWords[strings.ToLower(key)] {
+
+			return "", fmt.Errorf("invalid annotation key %q on rule %s", key, rule.Name)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		stringBuilder.WriteString("@")
+		stringBuilder.WriteString(key)
+		stringBuilder.WriteString("(")
+		stringBuilder.WriteString(strconv.Quote(rule.Annotations[key]))
+		stringBuilder.WriteString(")\n")
+	}
 	stringBuilder.WriteString("rule ")
 	stringBuilder.WriteString(rule.Name)
 	stringBuilder.WriteString(" ")
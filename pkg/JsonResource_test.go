@@ -296,3 +296,47 @@ func TestJsonNegation(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expecteJsonNegation, rs)
 }
+
+const jsonAnnotations = `{
+    "name": "SpeedUp",
+    "desc": "When testcar is speeding up we keep increase the speed.",
+    "salience": 10,
+    "annotations": {"ticket": "RISK-42", "owner": "risk-team"},
+    "when": "TestCar.SpeedUp == true",
+    "then": ["TestCar.Speed = TestCar.Speed + TestCar.SpeedIncrement"]
+}`
+
+const expectedAnnotations = `@owner("risk-team")
+@ticket("RISK-42")
+rule SpeedUp "When testcar is speeding up we keep increase the speed." salience 10 {
+    when
+        TestCar.SpeedUp == true
+    then
+        TestCar.Speed = TestCar.Speed + TestCar.SpeedIncrement;
+}
+`
+
+func TestJSONAnnotations(t *testing.T) {
+	rs, err := ParseJSONRule([]byte(jsonAnnotations))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedAnnotations, rs)
+
+	for _, key := range []string{"not a key", "_owner", "1owner", "rule", "When", "SALIENCE"} {
+		_, err = ParseRule(&GruleJSON{
+			Name:        "SpeedUp",
+			Annotations: map[string]string{key: "value"},
+			When:        "true",
+			Then:        []interface{}{"Retract(\"SpeedUp\")"},
+		})
+		assert.Error(t, err, key)
+	}
+
+	rs, err = ParseRule(&GruleJSON{
+		Name:        "SpeedUp",
+		Annotations: map[string]string{"propriétaire": "risk-team", "rules": "value"},
+		When:        "true",
+		Then:        []interface{}{"Retract(\"SpeedUp\")"},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, rs, "@propriétaire(\"risk-team\")")
+}
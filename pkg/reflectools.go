@@ -15,7 +15,10 @@
 package pkg
 
 import (
+	"encoding/binary"
 	"fmt"
+	"hash"
+	"hash/fnv"
 	"math"
 	"reflect"
 
@@ -672,3 +675,172 @@ func GetValueElem(val reflect.Value) reflect.Value {
 
 	return val
 }
+
+// DeepCopy returns a copy of the value that shares nothing with it that could be changed through the exported fields,
+// the pointers, slices, arrays, maps and interfaces are copied recursively, a pointer met twice is copied once.
+// The unexported fields of the structs, the channels and the functions are copied as they are.
+func DeepCopy(value reflect.Value) reflect.Value {
+
+	return deepCopy(value, make(map[deepCopyKey]reflect.Value))
+}
+
+// deepCopyKey identifies a pointer already copied by DeepCopy.
+type deepCopyKey struct {
+	typ     reflect.Type
+	pointer uintptr
+}
+
+func deepCopy(value reflect.Value, copied map[deepCopyKey]reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+
+			return value
+		}
+		key := deepCopyKey{typ: value.Type(), pointer: value.Pointer()}
+		if pointer, ok := copied[key]; ok {
+
+			return pointer
+		}
+		pointer := reflect.New(value.Type().Elem())
+		copied[key] = pointer
+		pointer.Elem().Set(deepCopy(value.Elem(), copied))
+
+		return pointer
+	case reflect.Interface:
+		if value.IsNil() {
+
+			return value
+		}
+		iface := reflect.New(value.Type()).Elem()
+		iface.Set(deepCopy(value.Elem(), copied))
+
+		return iface
+	case reflect.Struct:
+		strct := reflect.New(value.Type()).Elem()
+		strct.Set(value)
+		for i := 0; i < strct.NumField(); i++ {
+			if strct.Field(i).CanSet() {
+				strct.Field(i).Set(deepCopy(value.Field(i), copied))
+			}
+		}
+
+		return strct
+	case reflect.Slice:
+		if value.IsNil() {
+
+			return value
+		}
+		slice := reflect.MakeSlice(value.Type(), value.Len(), value.Cap())
+		for i := 0; i < value.Len(); i++ {
+			slice.Index(i).Set(deepCopy(value.Index(i), copied))
+		}
+
+		return slice
+	case reflect.Array:
+		array := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			array.Index(i).Set(deepCopy(value.Index(i), copied))
+		}
+
+		return array
+	case reflect.Map:
+		if value.IsNil() {
+
+			return value
+		}
+		mapValue := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			mapValue.SetMapIndex(iter.Key(), deepCopy(iter.Value(), copied))
+		}
+
+		return mapValue
+	default:
+
+		return value
+	}
+}
+
+// HashValue hashes the value along with everything it references, including the unexported fields of the structs.
+// Two values holding the same data have the same hash, whatever the iteration order of their maps.
+// The functions and the channels are hashed by their pointer.
+func HashValue(value reflect.Value) uint64 {
+	hasher := fnv.New64a()
+	hashValue(hasher, value, make(map[uintptr]bool))
+
+	return hasher.Sum64()
+}
+
+// hashValue writes the value into the hasher, the pointers being followed are skipped to stop at the cycles.
+func hashValue(hasher hash.Hash64, value reflect.Value, following map[uintptr]bool) {
+	buff := make([]byte, 8)
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(buff, u)
+		_, _ = hasher.Write(buff)
+	}
+	writeUint(uint64(value.Kind()))
+	switch value.Kind() {
+	case reflect.Invalid:
+	case reflect.Bool:
+		if value.Bool() {
+			writeUint(1)
+		} else {
+			writeUint(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(math.Float64bits(value.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		writeUint(math.Float64bits(real(value.Complex())))
+		writeUint(math.Float64bits(imag(value.Complex())))
+	case reflect.String:
+		writeUint(uint64(value.Len()))
+		_, _ = hasher.Write([]byte(value.String()))
+	case reflect.Ptr:
+		if value.IsNil() || following[value.Pointer()] {
+			writeUint(0)
+
+			return
+		}
+		writeUint(1)
+		following[value.Pointer()] = true
+		hashValue(hasher, value.Elem(), following)
+		delete(following, value.Pointer())
+	case reflect.Interface:
+		if value.IsNil() {
+			writeUint(0)
+
+			return
+		}
+		writeUint(1)
+		_, _ = hasher.Write([]byte(value.Elem().Type().String()))
+		hashValue(hasher, value.Elem(), following)
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			hashValue(hasher, value.Field(i), following)
+		}
+	case reflect.Slice, reflect.Array:
+		writeUint(uint64(value.Len()))
+		for i := 0; i < value.Len(); i++ {
+			hashValue(hasher, value.Index(i), following)
+		}
+	case reflect.Map:
+		writeUint(uint64(value.Len()))
+		// the entries are hashed apart and summed up, so their order doesn't matter.
+		var sum uint64
+		iter := value.MapRange()
+		for iter.Next() {
+			entryHasher := fnv.New64a()
+			hashValue(entryHasher, iter.Key(), following)
+			hashValue(entryHasher, iter.Value(), following)
+			sum += entryHasher.Sum64()
+		}
+		writeUint(sum)
+	default:
+		writeUint(uint64(value.Pointer()))
+	}
+}
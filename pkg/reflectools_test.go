@@ -506,3 +506,60 @@ func TestGetMapArrayValue(t *testing.T) {
 		t.Fail()
 	}
 }
+
+type deepCopyNode struct {
+	Name     string
+	Next     *deepCopyNode
+	Children []*deepCopyNode
+	Labels   map[string][]int
+	Any      interface{}
+	hidden   *int
+}
+
+func TestDeepCopy(t *testing.T) {
+	hidden := 3
+	root := &deepCopyNode{
+		Name:   "root",
+		Labels: map[string][]int{"a": {1, 2}},
+		Any:    []string{"x"},
+		hidden: &hidden,
+	}
+	child := &deepCopyNode{Name: "child", Next: root}
+	root.Children = []*deepCopyNode{child, child}
+	root.Next = root
+
+	copied := DeepCopy(reflect.ValueOf(root)).Interface().(*deepCopyNode)
+	if !reflect.DeepEqual(root, copied) {
+		t.Errorf("copy %v is not equal to %v", copied, root)
+	}
+	if copied == root || copied.Children[0] == child || copied.Next != copied || copied.Children[0] != copied.Children[1] || copied.Children[0].Next != copied {
+		t.Errorf("pointers are not copied once")
+	}
+	copied.Labels["a"][0] = 10
+	copied.Any.([]string)[0] = "y"
+	if root.Labels["a"][0] != 1 || root.Any.([]string)[0] != "x" {
+		t.Errorf("changing the copy changed the value")
+	}
+	if copied.hidden != root.hidden {
+		t.Errorf("unexported fields are not copied as they are")
+	}
+}
+
+func TestHashValue(t *testing.T) {
+	first := &deepCopyNode{Name: "first", Labels: map[string][]int{"a": {1}, "b": {2}, "c": {3}}}
+	first.Next = first
+	second := DeepCopy(reflect.ValueOf(first)).Interface().(*deepCopyNode)
+	if HashValue(reflect.ValueOf(first)) != HashValue(reflect.ValueOf(second)) {
+		t.Errorf("values holding the same data have different hashes")
+	}
+	second.Labels["b"][0] = 20
+	if HashValue(reflect.ValueOf(first)) == HashValue(reflect.ValueOf(second)) {
+		t.Errorf("values holding different data have the same hash")
+	}
+	hidden := 1
+	second = DeepCopy(reflect.ValueOf(first)).Interface().(*deepCopyNode)
+	second.hidden = &hidden
+	if HashValue(reflect.ValueOf(first)) == HashValue(reflect.ValueOf(second)) {
+		t.Errorf("unexported fields are not hashed")
+	}
+}